@@ -17,6 +17,10 @@ import (
 	"github.com/lib/pq"
 )
 
+// MaskedValue is the placeholder Console returns instead of secret fields. When an
+// object is saved with it, Console keeps the secret it already stores.
+const MaskedValue = "__MASKED_BY_JITSU__"
+
 // Client provides HTTP and optional DB access to the Jitsu Console API.
 type Client struct {
 	consoleURL  string
@@ -28,6 +32,17 @@ type Client struct {
 	dbOnce sync.Once
 	db     *sql.DB
 	dbErr  error
+
+	infoMu sync.Mutex
+	info   *ConsoleInfo
+}
+
+// ConsoleInfo describes the Console instance and the identity behind the auth token.
+type ConsoleInfo struct {
+	// AppConfig is the body of GET /api/app-config (public endpoints, feature flags).
+	AppConfig map[string]interface{}
+	// User is the authenticated user as returned by GET /api/init-user.
+	User map[string]interface{}
 }
 
 // New creates a new Jitsu API client. databaseURL is optional — needed only for soft-delete recovery.
@@ -112,6 +127,18 @@ func (c *Client) configItemURL(workspaceID, resourceType, id string) string {
 	)
 }
 
+func (c *Client) appConfigURL() string {
+	return fmt.Sprintf("%s/api/app-config", c.consoleURL)
+}
+
+func (c *Client) initUserURL() string {
+	return fmt.Sprintf("%s/api/init-user", c.consoleURL)
+}
+
+func (c *Client) destinationCheckURL(workspaceID string) string {
+	return fmt.Sprintf("%s/api/%s/destinations/check", c.consoleURL, url.PathEscape(workspaceID))
+}
+
 func (c *Client) workspaceURL() string {
 	return fmt.Sprintf("%s/api/workspace", c.consoleURL)
 }
@@ -120,6 +147,11 @@ func (c *Client) workspaceItemURL(idOrSlug string) string {
 	return fmt.Sprintf("%s/api/workspace/%s", c.consoleURL, url.PathEscape(idOrSlug))
 }
 
+// ConsoleURL returns the Console base URL without a trailing slash.
+func (c *Client) ConsoleURL() string {
+	return c.consoleURL
+}
+
 func (c *Client) doRequest(ctx context.Context, method, requestURL string, body interface{}) ([]byte, int, error) {
 	var reqBody io.Reader
 	if body != nil {
@@ -318,6 +350,32 @@ func (c *Client) DeleteLink(ctx context.Context, workspaceID, id string) error {
 	return nil
 }
 
+// TestDestination asks Console to test the connection of a destination config.
+// Returns an error carrying Console's message if the connection cannot be established.
+func (c *Client) TestDestination(ctx context.Context, workspaceID string, payload map[string]interface{}) error {
+	endpoint := c.destinationCheckURL(workspaceID)
+	body, status, err := c.doRequest(ctx, http.MethodPost, endpoint, payload)
+	if err != nil {
+		return err
+	}
+	if status < 200 || status >= 300 {
+		return fmt.Errorf("POST %s returned %d: %s", endpoint, status, string(body))
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("unmarshaling response: %w", err)
+	}
+	if ok, _ := result["ok"].(bool); !ok {
+		msg, _ := result["error"].(string)
+		if msg == "" {
+			msg = string(body)
+		}
+		return fmt.Errorf("connection test failed: %s", msg)
+	}
+	return nil
+}
+
 // WorkspaceCreate creates a workspace and returns its ID.
 func (c *Client) WorkspaceCreate(ctx context.Context, name, slug string) (string, error) {
 	payload := map[string]interface{}{
@@ -414,3 +472,54 @@ func (c *Client) WorkspaceDelete(ctx context.Context, workspaceID string) error
 	}
 	return nil
 }
+
+// ConsoleInfo verifies that Console is reachable and that the auth token is accepted,
+// returning the app config and the authenticated user. A successful result is reused by
+// every later caller; after an error the next call fetches again.
+func (c *Client) ConsoleInfo(ctx context.Context) (*ConsoleInfo, error) {
+	c.infoMu.Lock()
+	defer c.infoMu.Unlock()
+	if c.info != nil {
+		return c.info, nil
+	}
+	info, err := c.fetchConsoleInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.info = info
+	return info, nil
+}
+
+func (c *Client) fetchConsoleInfo(ctx context.Context) (*ConsoleInfo, error) {
+	appConfig, err := c.getJSON(ctx, c.appConfigURL())
+	if err != nil {
+		return nil, fmt.Errorf("checking Console connectivity: %w", err)
+	}
+
+	initUser, err := c.getJSON(ctx, c.initUserURL())
+	if err != nil {
+		return nil, fmt.Errorf("checking Console authentication: %w", err)
+	}
+	user, _ := initUser["user"].(map[string]interface{})
+	if user == nil {
+		user = initUser
+	}
+
+	return &ConsoleInfo{AppConfig: appConfig, User: user}, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, endpoint string) (map[string]interface{}, error) {
+	body, status, err := c.doRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if status < 200 || status >= 300 {
+		return nil, fmt.Errorf("GET %s returned %d: %s", endpoint, status, string(body))
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %w", err)
+	}
+	return result, nil
+}
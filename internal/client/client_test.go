@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConsoleInfo_RetriesAfterError(t *testing.T) {
+	ctx := context.Background()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/app-config":
+			_, _ = w.Write([]byte(`{"version": "2.9.1"}`))
+		case "/api/init-user":
+			_, _ = w.Write([]byte(`{"user": {"email": "admin@example.com"}}`))
+		}
+	}))
+	defer server.Close()
+	c := New(server.URL, "token", "", "test")
+
+	if _, err := c.ConsoleInfo(ctx); err == nil {
+		t.Fatal("expected the first call to fail")
+	}
+
+	info, err := c.ConsoleInfo(ctx)
+	if err != nil {
+		t.Fatalf("second call should fetch again and succeed: %v", err)
+	}
+	if info.AppConfig["version"] != "2.9.1" || info.User["email"] != "admin@example.com" {
+		t.Fatalf("unexpected info: %#v", info)
+	}
+
+	// A successful result is reused without further requests.
+	before := requests
+	if _, err := c.ConsoleInfo(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != before {
+		t.Fatalf("expected the cached result to be reused, got %d new request(s)", requests-before)
+	}
+}
@@ -0,0 +1,74 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccConfigObject_basic(t *testing.T) {
+	suffix := testAccSuffix()
+	objectID := "test_acc_cfg_" + suffix
+	code := `export default async function(event) { return event; }`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyRemote,
+		Steps: []resource.TestStep{
+			// Create and Read
+			{
+				Config: testAccConfigObjectConfig(t, suffix, objectID, "Config Object", code),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jitsu_config_object.test", "type", "function"),
+					resource.TestCheckResourceAttr("jitsu_config_object.test", "id", objectID),
+					resource.TestCheckResourceAttrPair("jitsu_config_object.test", "workspace_id", "jitsu_workspace.test", "id"),
+					testAccCheckFunctionRemote("jitsu_config_object.test", "Config Object", code),
+				),
+			},
+			// Update name
+			{
+				Config: testAccConfigObjectConfig(t, suffix, objectID, "Updated Config Object", code),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionRemote("jitsu_config_object.test", "Updated Config Object", code),
+				),
+			},
+			// Import
+			{
+				ResourceName: "jitsu_config_object.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					wsID := s.RootModule().Resources["jitsu_workspace.test"].Primary.ID
+					return wsID + "/function/" + objectID, nil
+				},
+				ImportStateVerify: true,
+				// Console may add defaults to imported objects; config is compared semantically on refresh.
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+		},
+	})
+}
+
+func testAccConfigObjectConfig(t *testing.T, suffix, objectID, name, code string) string {
+	providerConfig := testAccProviderConfig(t)
+	return fmt.Sprintf(`
+%s
+
+resource "jitsu_workspace" "test" {
+  name                = %q
+  slug                = %q
+  deletion_protection = false
+}
+
+resource "jitsu_config_object" "test" {
+  workspace_id = jitsu_workspace.test.id
+  type         = "function"
+  id           = %q
+  config = jsonencode({
+    name = %q
+    code = %q
+  })
+}
+`, providerConfig, testAccWorkspaceName("TF Config Object Workspace", suffix), testAccWorkspaceSlug("tf-acc-cfg", suffix), objectID, name, code)
+}
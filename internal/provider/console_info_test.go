@@ -0,0 +1,25 @@
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConsoleInfo_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(t) + `
+data "jitsu_console_info" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jitsu_console_info.test", "console_url", testAccConsoleURL()),
+					resource.TestCheckResourceAttrSet("data.jitsu_console_info.test", "ingest_url"),
+					resource.TestCheckResourceAttrSet("data.jitsu_console_info.test", "user_id"),
+				),
+			},
+		},
+	})
+}
@@ -27,6 +27,8 @@ func TestAccDestination_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("jitsu_destination.test", "clickhouse.protocol", "http"),
 					resource.TestCheckResourceAttr("jitsu_destination.test", "clickhouse.username", "reporting"),
 					resource.TestCheckResourceAttr("jitsu_destination.test", "clickhouse.database", "default"),
+					resource.TestCheckResourceAttrSet("jitsu_destination.test", "updated_at"),
+					resource.TestCheckResourceAttrPair("jitsu_destination.test", "last_applied_at", "jitsu_destination.test", "updated_at"),
 					testAccCheckDestinationRemote(
 						"jitsu_destination.test",
 						"Test Destination",
@@ -54,7 +56,7 @@ func TestAccDestination_basic(t *testing.T) {
 					),
 				),
 			},
-			// Import (password ignored — API returns masked value)
+			// Import (password ignored — API returns masked value; last_applied_at is only set by applies)
 			{
 				ResourceName: "jitsu_destination.test",
 				ImportState:  true,
@@ -63,7 +65,7 @@ func TestAccDestination_basic(t *testing.T) {
 					return wsID + "/" + destinationID, nil
 				},
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"clickhouse.password"},
+				ImportStateVerifyIgnore: []string{"clickhouse.password", "last_applied_at"},
 			},
 		},
 	})
@@ -93,6 +95,26 @@ func TestAccDestination_validationRequiresMatchingBlock(t *testing.T) {
 				Config:      testAccDestinationValidationConfig(t, "clickhouse", "both", false),
 				ExpectError: regexp.MustCompile(`"clickhouse" destinations cannot define the bigquery block\.`),
 			},
+			{
+				Config:      testAccDestinationValidationConfig(t, "bigquery", "bigquery-wrong-project", false),
+				ExpectError: regexp.MustCompile(`belongs to project "other-project", but project_id is "project-id"\.`),
+			},
+			{
+				Config:      testAccDestinationValidationConfig(t, "postgres", "", false),
+				ExpectError: regexp.MustCompile(`"postgres" destinations must define the postgres block\.`),
+			},
+			{
+				Config:      testAccDestinationValidationConfig(t, "postgres", "postgres-bad-ssl", false),
+				ExpectError: regexp.MustCompile(`ssl_mode must be one of`),
+			},
+			{
+				Config:      testAccDestinationValidationConfig(t, "hubspot", "", false),
+				ExpectError: regexp.MustCompile(`"hubspot" destinations have no dedicated block and must set config\.`),
+			},
+			{
+				Config:      testAccDestinationValidationConfig(t, "webhook", "clickhouse", false),
+				ExpectError: regexp.MustCompile(`"webhook" destinations cannot define the clickhouse block\.`),
+			},
 		},
 	})
 }
@@ -103,8 +125,9 @@ func testAccDestinationConfig(t *testing.T, suffix, destinationID, name string)
 %s
 
 resource "jitsu_workspace" "test" {
-  name = %q
-  slug = %q
+  name                = %q
+  slug                = %q
+  deletion_protection = false
 }
 
 resource "jitsu_destination" "test" {
@@ -133,8 +156,9 @@ func testAccDestinationValidationConfig(t *testing.T, destinationType, block str
 		workspaceID = "jitsu_workspace.test.id"
 		workspaceConfig = `
 resource "jitsu_workspace" "test" {
-  name = "Validation Workspace"
-  slug = "validation-workspace"
+  name                = "Validation Workspace"
+  slug                = "validation-workspace"
+  deletion_protection = false
 }
 `
 	}
@@ -150,10 +174,27 @@ resource "jitsu_workspace" "test" {
 	case "bigquery":
 		resourceBody = `
   bigquery = {
-    credentials = "{}"
+    credentials = jsonencode({ type = "service_account", project_id = "project-id" })
     project_id  = "project-id"
     bq_dataset  = "dataset"
   }
+`
+	case "bigquery-wrong-project":
+		resourceBody = `
+  bigquery = {
+    credentials = jsonencode({ type = "service_account", project_id = "other-project" })
+    project_id  = "project-id"
+    bq_dataset  = "dataset"
+  }
+`
+	case "postgres-bad-ssl":
+		resourceBody = `
+  postgres = {
+    host     = "postgres"
+    database = "events"
+    username = "writer"
+    ssl_mode = "prefer"
+  }
 `
 	case "both":
 		resourceBody = `
@@ -161,7 +202,7 @@ resource "jitsu_workspace" "test" {
     hosts = ["clickhouse:8123"]
   }
   bigquery = {
-    credentials = "{}"
+    credentials = jsonencode({ type = "service_account", project_id = "project-id" })
     project_id  = "project-id"
     bq_dataset  = "dataset"
   }
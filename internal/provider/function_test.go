@@ -5,7 +5,11 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccFunction_basic(t *testing.T) {
@@ -49,14 +53,44 @@ func TestAccFunction_basic(t *testing.T) {
 	})
 }
 
+func TestAccFunction_importIdentity(t *testing.T) {
+	suffix := testAccSuffix()
+	functionID := "test_acc_func_id_" + suffix
+	code := `export default async function(event) { return event; }`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyRemote,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig(t, suffix, functionID, "Test Function", code),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValue("jitsu_function.test", tfjsonpath.New("id"), knownvalue.StringExact(functionID)),
+				},
+			},
+			// Import via an import block with identity
+			{
+				Config:          testAccFunctionConfig(t, suffix, functionID, "Test Function", code),
+				ResourceName:    "jitsu_function.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testAccFunctionConfig(t *testing.T, suffix, functionID, name, code string) string {
 	providerConfig := testAccProviderConfig(t)
 	return fmt.Sprintf(`
 %s
 
 resource "jitsu_workspace" "test" {
-  name = %q
-  slug = %q
+  name                = %q
+  slug                = %q
+  deletion_protection = false
 }
 
 resource "jitsu_function" "test" {
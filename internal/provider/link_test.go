@@ -57,8 +57,9 @@ func testAccLinkConfig(t *testing.T, suffix, streamID, destinationID string, bat
 %s
 
 resource "jitsu_workspace" "test" {
-  name = %[2]q
-  slug = %[3]q
+  name                = %[2]q
+  slug                = %[3]q
+  deletion_protection = false
 }
 
 resource "jitsu_stream" "link_test" {
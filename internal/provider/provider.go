@@ -6,14 +6,24 @@ import (
 
 	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
 	"github.com/chilipiper/terraform-provider-jitsu/internal/resources"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-var _ provider.Provider = &jitsuProvider{}
+var (
+	_ provider.Provider                       = &jitsuProvider{}
+	_ provider.ProviderWithEphemeralResources = &jitsuProvider{}
+	_ provider.ProviderWithFunctions          = &jitsuProvider{}
+	_ provider.ProviderWithListResources      = &jitsuProvider{}
+	_ provider.ProviderWithActions            = &jitsuProvider{}
+)
 
 type jitsuProvider struct {
 	version string
@@ -98,6 +108,8 @@ func (p *jitsuProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	c := client.New(consoleURL, authToken, databaseURL, userAgent)
 	resp.ResourceData = c
 	resp.DataSourceData = c
+	resp.ListResourceData = c
+	resp.ActionData = c
 }
 
 func (p *jitsuProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -107,9 +119,42 @@ func (p *jitsuProvider) Resources(_ context.Context) []func() resource.Resource
 		resources.NewDestinationResource,
 		resources.NewStreamResource,
 		resources.NewLinkResource,
+		resources.NewConfigObjectResource,
 	}
 }
 
 func (p *jitsuProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		resources.NewConsoleInfoDataSource,
+	}
+}
+
+func (p *jitsuProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		resources.NewStreamKeyEphemeralResource,
+	}
+}
+
+func (p *jitsuProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		resources.NewKeyHintFunction,
+		resources.NewFunctionRefFunction,
+		resources.NewIsValidFunctionIDFunction,
+		resources.NewParseImportIDFunction,
+	}
+}
+
+func (p *jitsuProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		resources.NewStreamListResource,
+		resources.NewDestinationListResource,
+		resources.NewFunctionListResource,
+		resources.NewLinkListResource,
+	}
+}
+
+func (p *jitsuProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		resources.NewDestinationTestAction,
+	}
 }
@@ -589,6 +589,32 @@ func testAccCheckDestroyRemote(s *terraform.State) error {
 					return fmt.Errorf("%s: %w", resourceName, err)
 				}
 
+			case "jitsu_config_object":
+				workspaceID, err := testAccRequiredAttr(rs, "workspace_id")
+				if err != nil {
+					return fmt.Errorf("%s: %w", resourceName, err)
+				}
+				resourceType, err := testAccRequiredAttr(rs, "type")
+				if err != nil {
+					return fmt.Errorf("%s: %w", resourceName, err)
+				}
+				id, err := testAccRequiredAttr(rs, "id")
+				if err != nil {
+					return fmt.Errorf("%s: %w", resourceName, err)
+				}
+
+				result, err := c.Read(ctx, workspaceID, resourceType, id)
+				if err != nil && !isNotFoundError(err) {
+					return fmt.Errorf("%s: reading %s from API: %w", resourceName, resourceType, err)
+				}
+				if err == nil && result != nil {
+					return fmt.Errorf("%s: %s %q still exists in API after destroy", resourceName, resourceType, id)
+				}
+
+				if err := testAccCheckConfigObjectDeletedInDB(ctx, db, id); err != nil {
+					return fmt.Errorf("%s: %w", resourceName, err)
+				}
+
 			case "jitsu_link":
 				workspaceID, err := testAccRequiredAttr(rs, "workspace_id")
 				if err != nil {
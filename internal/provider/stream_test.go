@@ -55,8 +55,9 @@ func testAccStreamConfig(t *testing.T, suffix, streamID, keyID, name string) str
 %s
 
 resource "jitsu_workspace" "test" {
-  name = %q
-  slug = %q
+  name                = %q
+  slug                = %q
+  deletion_protection = false
 }
 
 resource "jitsu_stream" "test" {
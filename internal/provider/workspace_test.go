@@ -2,6 +2,7 @@ package provider_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -20,6 +21,7 @@ func TestAccWorkspace_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("jitsu_workspace.test", "name", testAccWorkspaceName("Test Workspace", suffix)),
 					resource.TestCheckResourceAttr("jitsu_workspace.test", "slug", testAccWorkspaceSlug("tf-acc-ws", suffix)),
 					resource.TestCheckResourceAttrSet("jitsu_workspace.test", "id"),
+					resource.TestCheckResourceAttr("jitsu_workspace.test", "deletion_protection", "false"),
 					testAccCheckWorkspaceRemote(
 						"jitsu_workspace.test",
 						testAccWorkspaceName("Test Workspace", suffix),
@@ -43,6 +45,8 @@ func TestAccWorkspace_basic(t *testing.T) {
 				ResourceName:      "jitsu_workspace.test",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Imported workspaces are protected by default; the test config disables protection.
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -53,9 +57,46 @@ func testAccWorkspaceConfig(t *testing.T, suffix, name, slug string) string {
 	return fmt.Sprintf(`
 %s
 
+resource "jitsu_workspace" "test" {
+  name                = %q
+  slug                = %q
+  deletion_protection = false
+}
+`, providerConfig, testAccWorkspaceName(name, suffix), slug)
+}
+
+func TestAccWorkspace_deletionProtection(t *testing.T) {
+	suffix := testAccSuffix()
+	name := testAccWorkspaceName("Protected Workspace", suffix)
+	slug := testAccWorkspaceSlug("tf-acc-ws-protected", suffix)
+	providerConfig := testAccProviderConfig(t)
+
+	protectedConfig := fmt.Sprintf(`
+%s
+
 resource "jitsu_workspace" "test" {
   name = %q
   slug = %q
 }
-`, providerConfig, testAccWorkspaceName(name, suffix), slug)
+`, providerConfig, name, slug)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDestroyRemote,
+		Steps: []resource.TestStep{
+			{
+				Config: protectedConfig,
+				Check:  resource.TestCheckResourceAttr("jitsu_workspace.test", "deletion_protection", "true"),
+			},
+			{
+				Config:      protectedConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`Deletion protection is enabled`),
+			},
+			{
+				Config: testAccWorkspaceConfig(t, suffix, "Protected Workspace", slug),
+				Check:  resource.TestCheckResourceAttr("jitsu_workspace.test", "deletion_protection", "false"),
+			},
+		},
+	})
 }
@@ -0,0 +1,306 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &configObjectResource{}
+	_ resource.ResourceWithImportState    = &configObjectResource{}
+	_ resource.ResourceWithValidateConfig = &configObjectResource{}
+	_ resource.ResourceWithIdentity       = &configObjectResource{}
+)
+
+type configObjectResource struct {
+	client *client.Client
+}
+
+type configObjectModel struct {
+	WorkspaceID     types.String `tfsdk:"workspace_id"`
+	Type            types.String `tfsdk:"type"`
+	ID              types.String `tfsdk:"id"`
+	Config          types.String `tfsdk:"config"`
+	SensitiveConfig types.String `tfsdk:"sensitive_config"`
+}
+
+type configObjectIdentityModel struct {
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Type        types.String `tfsdk:"type"`
+	ID          types.String `tfsdk:"id"`
+}
+
+func NewConfigObjectResource() resource.Resource {
+	return &configObjectResource{}
+}
+
+func (r *configObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_object"
+}
+
+func (r *configObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an arbitrary Jitsu config object through Console's generic config API " +
+			"(/api/{workspace_id}/config/{type}). Use it for object types without a dedicated resource.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Jitsu workspace ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Config object type (e.g., function, service). Links are not supported.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "Object ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config": schema.StringAttribute{
+				Required: true,
+				Description: "JSON-encoded object configuration (use jsonencode). Formatting and key order " +
+					"differences from Console's copy are not reported as drift.",
+			},
+			"sensitive_config": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "JSON-encoded secret fields merged into config at apply time. Console returns masked " +
+					"values for secrets, so these are preserved from state on read. Keys must not overlap with config or set " +
+					"id, workspaceId, type, deleted, createdAt or updatedAt.",
+			},
+		},
+	}
+}
+
+func (r *configObjectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Jitsu workspace ID.",
+			},
+			"type": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Config object type.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Object ID.",
+			},
+		},
+	}
+}
+
+func (r *configObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureClient(req, resp)
+}
+
+func (r *configObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config configObjectModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsNull() && !config.Type.IsUnknown() && config.Type.ValueString() == "link" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Unsupported config object type",
+			"Links use a different Console API. Use the jitsu_link resource instead.",
+		)
+	}
+
+	resp.Diagnostics.Append(validateJSONConfig(config.Config, config.SensitiveConfig, "config", "sensitive_config", systemFields)...)
+}
+
+func (r *configObjectResource) buildPayload(plan *configObjectModel) (map[string]interface{}, error) {
+	payload, err := decodeJSONObject(plan.Config.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("config %w", err)
+	}
+	if !plan.SensitiveConfig.IsNull() && !plan.SensitiveConfig.IsUnknown() {
+		secret, err := decodeJSONObject(plan.SensitiveConfig.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("sensitive_config %w", err)
+		}
+		for k, v := range secret {
+			payload[k] = v
+		}
+	}
+
+	payload["id"] = plan.ID.ValueString()
+	payload["workspaceId"] = plan.WorkspaceID.ValueString()
+	payload["type"] = plan.Type.ValueString()
+	return payload, nil
+}
+
+func (r *configObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan configObjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.buildPayload(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error building payload", err.Error())
+		return
+	}
+
+	_, err = r.client.Create(ctx, plan.WorkspaceID.ValueString(), plan.Type.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating config object", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, &plan)...)
+}
+
+// readAPIIntoState updates config from the API result. System fields and keys
+// managed through sensitive_config are dropped first, and the state value is
+// kept when it is semantically equal to what Console returned.
+func (r *configObjectResource) readAPIIntoState(result map[string]interface{}, state *configObjectModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	config, err := readJSONConfig(result, systemFields, state.SensitiveConfig, state.Config)
+	if err != nil {
+		diags.AddError("Error encoding config", err.Error())
+		return diags
+	}
+	state.Config = config
+	return diags
+}
+
+func (r *configObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state configObjectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.Read(ctx, state.WorkspaceID.ValueString(), state.Type.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading config object", err.Error())
+		return
+	}
+	if result == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.readAPIIntoState(result, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, &state)...)
+}
+
+func (r *configObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan configObjectModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, err := r.buildPayload(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error building payload", err.Error())
+		return
+	}
+
+	_, err = r.client.Update(ctx, plan.WorkspaceID.ValueString(), plan.Type.ValueString(), plan.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating config object", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, &plan)...)
+}
+
+func (r *configObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state configObjectModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Delete(ctx, state.WorkspaceID.ValueString(), state.Type.ValueString(), state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error deleting config object", err.Error())
+	}
+}
+
+func (r *configObjectResource) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, m *configObjectModel) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, configObjectIdentityModel{
+		WorkspaceID: m.WorkspaceID,
+		Type:        m.Type,
+		ID:          m.ID,
+	})
+}
+
+func (r *configObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var wsID, objType, id string
+	if req.ID == "" && req.Identity != nil {
+		var identity configObjectIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		wsID, objType, id = identity.WorkspaceID.ValueString(), identity.Type.ValueString(), identity.ID.ValueString()
+	} else {
+		parts, err := parseImportID("config_object", req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", err.Error())
+			return
+		}
+		wsID, objType, id = parts["workspace_id"], parts["type"], parts["id"]
+	}
+	if wsID == "" || objType == "" || id == "" {
+		resp.Diagnostics.AddError("Invalid import identity", "workspace_id, type and id must all be set.")
+		return
+	}
+
+	result, err := r.client.Read(ctx, wsID, objType, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing config object", err.Error())
+		return
+	}
+	if result == nil {
+		resp.Diagnostics.AddError("Config object not found", fmt.Sprintf("%s %s not found in workspace %s", objType, id, wsID))
+		return
+	}
+
+	state := configObjectModel{
+		WorkspaceID:     types.StringValue(wsID),
+		Type:            types.StringValue(objType),
+		ID:              types.StringValue(id),
+		Config:          types.StringNull(),
+		SensitiveConfig: types.StringNull(),
+	}
+	resp.Diagnostics.Append(r.readAPIIntoState(result, &state)...)
+	// Secret fields are imported into config with the masked values Console returns.
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.setIdentity(ctx, resp.Identity, &state)...)
+}
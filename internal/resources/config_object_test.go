@@ -0,0 +1,142 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfigObjectBuildPayload_MergesSensitiveConfig(t *testing.T) {
+	plan := configObjectModel{
+		WorkspaceID:     types.StringValue("ws"),
+		Type:            types.StringValue("service"),
+		ID:              types.StringValue("svc"),
+		Config:          types.StringValue(`{"name":"Service","port":8080}`),
+		SensitiveConfig: types.StringValue(`{"token":"secret"}`),
+	}
+
+	payload, err := (&configObjectResource{}).buildPayload(&plan)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"id":          "svc",
+		"workspaceId": "ws",
+		"type":        "service",
+		"name":        "Service",
+		"port":        float64(8080),
+		"token":       "secret",
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Fatalf("payload = %v, want %v", payload, want)
+	}
+}
+
+func TestConfigObjectReadAPIIntoState_IgnoresFormattingAndSecrets(t *testing.T) {
+	state := configObjectModel{
+		Config:          types.StringValue(`{ "port": 8080.0, "name": "Service" }`),
+		SensitiveConfig: types.StringValue(`{"token":"secret"}`),
+	}
+
+	result := map[string]interface{}{
+		"id":          "svc",
+		"workspaceId": "ws",
+		"type":        "service",
+		"updatedAt":   "2024-01-01T00:00:00Z",
+		"name":        "Service",
+		"port":        float64(8080),
+		"token":       "********",
+	}
+
+	diags := (&configObjectResource{}).readAPIIntoState(result, &state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := state.Config.ValueString(); got != `{ "port": 8080.0, "name": "Service" }` {
+		t.Fatalf("expected config to be kept as written, got %q", got)
+	}
+	if got := state.SensitiveConfig.ValueString(); got != `{"token":"secret"}` {
+		t.Fatalf("expected sensitive_config to be preserved, got %q", got)
+	}
+}
+
+func TestConfigObjectReadAPIIntoState_DetectsDrift(t *testing.T) {
+	state := configObjectModel{
+		Config:          types.StringValue(`{"name":"Service"}`),
+		SensitiveConfig: types.StringNull(),
+	}
+
+	result := map[string]interface{}{
+		"id":   "svc",
+		"name": "Renamed",
+		"port": float64(8080),
+	}
+
+	diags := (&configObjectResource{}).readAPIIntoState(result, &state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got := state.Config.ValueString(); got != `{"name":"Renamed","port":8080}` {
+		t.Fatalf("unexpected config %q", got)
+	}
+}
+
+func TestConfigObjectValidateConfig_ReservedKeys(t *testing.T) {
+	ctx := context.Background()
+	r := &configObjectResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	tests := map[string]struct {
+		config, sensitiveConfig string
+		wantPath                path.Path
+	}{
+		"valid":                      {config: `{"name":"Service"}`, sensitiveConfig: `{"token":"secret"}`},
+		"reserved in config":         {config: `{"type":"function"}`, wantPath: path.Root("config")},
+		"reserved in sensitive":      {config: `{}`, sensitiveConfig: `{"workspaceId":"other"}`, wantPath: path.Root("sensitive_config")},
+		"sensitive overlaps config":  {config: `{"token":"x"}`, sensitiveConfig: `{"token":"y"}`, wantPath: path.Root("sensitive_config")},
+		"sensitive is not an object": {config: `{}`, sensitiveConfig: `[]`, wantPath: path.Root("sensitive_config")},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sensitive := tftypes.NewValue(tftypes.String, nil)
+			if tc.sensitiveConfig != "" {
+				sensitive = tftypes.NewValue(tftypes.String, tc.sensitiveConfig)
+			}
+			config := tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+					"workspace_id":     tftypes.NewValue(tftypes.String, "ws"),
+					"type":             tftypes.NewValue(tftypes.String, "service"),
+					"id":               tftypes.NewValue(tftypes.String, "svc"),
+					"config":           tftypes.NewValue(tftypes.String, tc.config),
+					"sensitive_config": sensitive,
+				}),
+			}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+			if len(tc.wantPath.Steps()) == 0 {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got %v", resp.Diagnostics)
+			}
+			if d, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(tc.wantPath) {
+				t.Fatalf("expected an error at %s, got %v", tc.wantPath, resp.Diagnostics)
+			}
+		})
+	}
+}
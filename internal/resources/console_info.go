@@ -0,0 +1,173 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &consoleInfoDataSource{}
+
+type consoleInfoDataSource struct {
+	client *client.Client
+}
+
+type consoleInfoModel struct {
+	ConsoleURL   types.String `tfsdk:"console_url"`
+	Version      types.String `tfsdk:"version"`
+	IngestURL    types.String `tfsdk:"ingest_url"`
+	DataHost     types.String `tfsdk:"data_host"`
+	UserID       types.String `tfsdk:"user_id"`
+	UserEmail    types.String `tfsdk:"user_email"`
+	UserName     types.String `tfsdk:"user_name"`
+	Capabilities types.List   `tfsdk:"capabilities"`
+}
+
+// consoleCapabilities maps capability names to the app-config flag that enables them.
+var consoleCapabilities = map[string][]string{
+	"billing":           {"billingEnabled"},
+	"credentials_login": {"credentialsLoginEnabled"},
+	"ee":                {"ee", "available"},
+	"syncs":             {"syncs", "enabled"},
+}
+
+func NewConsoleInfoDataSource() datasource.DataSource {
+	return &consoleInfoDataSource{}
+}
+
+func (d *consoleInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_console_info"
+}
+
+func (d *consoleInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reports information about the configured Jitsu Console: version, public endpoints, " +
+			"the authenticated user and enabled capabilities.",
+		Attributes: map[string]schema.Attribute{
+			"console_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Console base URL the provider is configured with.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Console version. Null when the Console build does not report one.",
+			},
+			"ingest_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public ingestion base URL (protocol://host[:port]) events are sent to.",
+			},
+			"data_host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public data host used in tracking snippets. Falls back to the ingestion host.",
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the user the auth token belongs to.",
+			},
+			"user_email": schema.StringAttribute{
+				Computed:    true,
+				Description: "Email of the user the auth token belongs to.",
+			},
+			"user_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the user the auth token belongs to.",
+			},
+			"capabilities": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Sorted list of enabled Console capabilities (billing, credentials_login, ee, syncs).",
+			},
+		},
+	}
+}
+
+func (d *consoleInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *consoleInfoDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	info, err := d.client.ConsoleInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Console info", err.Error())
+		return
+	}
+
+	state := consoleInfoModel{
+		ConsoleURL: types.StringValue(d.client.ConsoleURL()),
+	}
+	resp.Diagnostics.Append(readConsoleInfoIntoState(ctx, info, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func readConsoleInfoIntoState(ctx context.Context, info *client.ConsoleInfo, state *consoleInfoModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.Version = stringOrNull(info.AppConfig["version"])
+
+	endpoints, _ := info.AppConfig["publicEndpoints"].(map[string]interface{})
+	host, _ := endpoints["host"].(string)
+	if host != "" {
+		protocol, _ := endpoints["protocol"].(string)
+		if protocol == "" {
+			protocol = "https"
+		}
+		ingestURL := protocol + "://" + host
+		if port, ok := toInt64(endpoints["port"]); ok && port > 0 {
+			ingestURL = fmt.Sprintf("%s:%d", ingestURL, port)
+		}
+		state.IngestURL = types.StringValue(ingestURL)
+	} else {
+		state.IngestURL = types.StringNull()
+	}
+	if v, ok := endpoints["dataHost"].(string); ok && v != "" {
+		state.DataHost = types.StringValue(v)
+	} else if host != "" {
+		state.DataHost = types.StringValue(host)
+	} else {
+		state.DataHost = types.StringNull()
+	}
+
+	state.UserID = stringOrNull(info.User["id"])
+	state.UserEmail = stringOrNull(info.User["email"])
+	state.UserName = stringOrNull(info.User["name"])
+
+	capabilities := make([]string, 0, len(consoleCapabilities))
+	for name, flagPath := range consoleCapabilities {
+		if enabled, _ := lookupPath(info.AppConfig, flagPath).(bool); enabled {
+			capabilities = append(capabilities, name)
+		}
+	}
+	sort.Strings(capabilities)
+	capList, d := types.ListValueFrom(ctx, types.StringType, capabilities)
+	diags.Append(d...)
+	state.Capabilities = capList
+
+	return diags
+}
+
+// stringOrNull returns v as a types.String, or null if v is not a non-empty string.
+func stringOrNull(v interface{}) types.String {
+	if s, ok := v.(string); ok && s != "" {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
+
+// lookupPath walks nested JSON objects along keys and returns the value found, or nil.
+func lookupPath(obj map[string]interface{}, keys []string) interface{} {
+	var cur interface{} = obj
+	for _, k := range keys {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		cur = m[k]
+	}
+	return cur
+}
@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+)
+
+func TestReadConsoleInfoIntoState(t *testing.T) {
+	ctx := context.Background()
+
+	info := &client.ConsoleInfo{
+		AppConfig: map[string]interface{}{
+			"version": "2.9.1",
+			"publicEndpoints": map[string]interface{}{
+				"protocol": "https",
+				"host":     "ingest.example.com",
+				"dataHost": "data.example.com",
+				"port":     float64(8443),
+			},
+			"billingEnabled":          false,
+			"credentialsLoginEnabled": true,
+			"ee":                      map[string]interface{}{"available": true},
+			"syncs":                   map[string]interface{}{"enabled": false},
+		},
+		User: map[string]interface{}{
+			"id":    "user-id",
+			"email": "admin@example.com",
+			"name":  "Admin",
+		},
+	}
+
+	var state consoleInfoModel
+	diags := readConsoleInfoIntoState(ctx, info, &state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if state.Version.ValueString() != "2.9.1" {
+		t.Fatalf("version mismatch: got %v", state.Version)
+	}
+	if state.IngestURL.ValueString() != "https://ingest.example.com:8443" {
+		t.Fatalf("ingest_url mismatch: got %v", state.IngestURL)
+	}
+	if state.DataHost.ValueString() != "data.example.com" {
+		t.Fatalf("data_host mismatch: got %v", state.DataHost)
+	}
+	if state.UserID.ValueString() != "user-id" || state.UserEmail.ValueString() != "admin@example.com" || state.UserName.ValueString() != "Admin" {
+		t.Fatalf("user mismatch: got %v %v %v", state.UserID, state.UserEmail, state.UserName)
+	}
+
+	var capabilities []string
+	diags = state.Capabilities.ElementsAs(ctx, &capabilities, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading capabilities: %v", diags)
+	}
+	if !reflect.DeepEqual(capabilities, []string{"credentials_login", "ee"}) {
+		t.Fatalf("capabilities mismatch: got %v", capabilities)
+	}
+}
+
+func TestReadConsoleInfoIntoState_MissingFields(t *testing.T) {
+	ctx := context.Background()
+
+	info := &client.ConsoleInfo{
+		AppConfig: map[string]interface{}{
+			"publicEndpoints": map[string]interface{}{
+				"host": "localhost",
+			},
+		},
+		User: map[string]interface{}{},
+	}
+
+	var state consoleInfoModel
+	diags := readConsoleInfoIntoState(ctx, info, &state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !state.Version.IsNull() {
+		t.Fatalf("version should be null, got %v", state.Version)
+	}
+	if state.IngestURL.ValueString() != "https://localhost" {
+		t.Fatalf("ingest_url mismatch: got %v", state.IngestURL)
+	}
+	if state.DataHost.ValueString() != "localhost" {
+		t.Fatalf("data_host should fall back to host, got %v", state.DataHost)
+	}
+	if !state.UserEmail.IsNull() {
+		t.Fatalf("user_email should be null, got %v", state.UserEmail)
+	}
+	if len(state.Capabilities.Elements()) != 0 {
+		t.Fatalf("capabilities should be empty, got %v", state.Capabilities)
+	}
+}
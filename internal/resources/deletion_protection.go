@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute is the deletion_protection attribute shared by
+// destinations, streams and workspaces. Unlike lifecycle.prevent_destroy it
+// can be set from module inputs.
+func deletionProtectionAttribute(defaultValue bool, what string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(defaultValue),
+		Description: fmt.Sprintf("When true, destroying the %s fails. Set to false and apply before destroying or replacing it. "+
+			"Defaults to %t.", what, defaultValue),
+	}
+}
+
+// checkDeletionProtection returns an error when the object being deleted has
+// deletion_protection enabled. kind and id name the object in the message.
+func checkDeletionProtection(protected types.Bool, kind, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !protected.ValueBool() {
+		return diags
+	}
+	diags.AddAttributeError(
+		path.Root("deletion_protection"),
+		"Deletion protection is enabled",
+		fmt.Sprintf("Cannot delete %s %q because deletion_protection is true. Set deletion_protection = false "+
+			"and apply that change before destroying or replacing it.", kind, id),
+	)
+	return diags
+}
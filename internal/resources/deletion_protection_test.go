@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	tests := map[string]struct {
+		protected types.Bool
+		errors    int
+	}{
+		"enabled":  {protected: types.BoolValue(true), errors: 1},
+		"disabled": {protected: types.BoolValue(false), errors: 0},
+		"null":     {protected: types.BoolNull(), errors: 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := checkDeletionProtection(tc.protected, "stream", "site-website")
+			if got := diags.ErrorsCount(); got != tc.errors {
+				t.Fatalf("expected %d errors, got %d: %v", tc.errors, got, diags)
+			}
+			if tc.errors > 0 && !strings.Contains(diags.Errors()[0].Detail(), `stream "site-website"`) {
+				t.Fatalf("error should name the stream, got %q", diags.Errors()[0].Detail())
+			}
+		})
+	}
+}
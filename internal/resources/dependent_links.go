@@ -0,0 +1,169 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Deleting a stream or destination only soft-deletes it in Console, and links
+// that reference it are left in place pointing at the deleted object. Streams
+// and destinations therefore look up their live links before being destroyed.
+
+// dependentLink is a live Console link from or to the object being deleted.
+type dependentLink struct {
+	ID, FromID, ToID string
+}
+
+func (l dependentLink) String() string {
+	return fmt.Sprintf("%s (%s -> %s)", l.ID, l.FromID, l.ToID)
+}
+
+// cascadeDeleteLinksAttribute is the cascade_delete_links attribute shared by
+// streams and destinations.
+func cascadeDeleteLinksAttribute(what string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Description: fmt.Sprintf("Delete the Console links from or to this %s before destroying it, so no link is left "+
+			"pointing at a deleted %s. Defaults to false, which leaves such links in place and warns about them.", what, what),
+	}
+}
+
+// findDependentLinks returns the live links whose source or destination is id.
+func findDependentLinks(ctx context.Context, c *client.Client, workspaceID, id string) ([]dependentLink, error) {
+	links, err := c.List(ctx, workspaceID, "link")
+	if err != nil {
+		return nil, fmt.Errorf("listing links: %w", err)
+	}
+	var dependent []dependentLink
+	for _, link := range links {
+		linkID, _ := link["id"].(string)
+		fromID, _ := link["fromId"].(string)
+		toID, _ := link["toId"].(string)
+		deleted, _ := link["deleted"].(bool)
+		if deleted || (fromID != id && toID != id) {
+			continue
+		}
+		dependent = append(dependent, dependentLink{ID: linkID, FromID: fromID, ToID: toID})
+	}
+	return dependent, nil
+}
+
+func describeLinks(links []dependentLink) string {
+	descriptions := make([]string, len(links))
+	for i, l := range links {
+		descriptions[i] = l.String()
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// planDestroys reports whether the plan destroys the object in state: either
+// it is removed, or one of the given attributes, which all require
+// replacement, changes.
+func planDestroys(ctx context.Context, req resource.ModifyPlanRequest, replaceAttrs ...string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() {
+		return false, diags
+	}
+	if req.Plan.Raw.IsNull() {
+		return true, diags
+	}
+	for _, name := range replaceAttrs {
+		var before, after types.String
+		diags.Append(req.State.GetAttribute(ctx, path.Root(name), &before)...)
+		diags.Append(req.Plan.GetAttribute(ctx, path.Root(name), &after)...)
+		if !after.IsUnknown() && !after.Equal(before) {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+// planDependentLinks warns when destroying or replacing the object in state
+// leaves live links behind, or lists the links cascade_delete_links will
+// delete. kind names the object in messages (stream or destination).
+func planDependentLinks(ctx context.Context, c *client.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, kind string) {
+	if c == nil || req.State.Raw.IsNull() {
+		return
+	}
+	var workspaceID, id types.String
+	var cascade types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("workspace_id"), &workspaceID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cascade_delete_links"), &cascade)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	links, err := findDependentLinks(ctx, c, workspaceID.ValueString(), id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Cannot check links",
+			fmt.Sprintf("Cannot check for links referencing %s %q: %s", kind, id.ValueString(), err.Error()),
+		)
+		return
+	}
+	if len(links) == 0 {
+		return
+	}
+	if cascade.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Links to this %s will be deleted", kind),
+			fmt.Sprintf("Destroying %s %q first deletes %d link(s) because cascade_delete_links is true: %s.",
+				kind, id.ValueString(), len(links), describeLinks(links)),
+		)
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("Links will point at a deleted %s", kind),
+		fmt.Sprintf("Links referencing %s %q: %s. Links that are not destroyed in the same apply stay in Console pointing "+
+			"at the deleted %s. Destroy them too, or set cascade_delete_links = true and apply before destroying.",
+			kind, id.ValueString(), describeLinks(links), kind),
+	)
+}
+
+// deleteDependentLinks handles the links of an object that is about to be
+// deleted: with cascade they are deleted, otherwise a warning lists them.
+func deleteDependentLinks(ctx context.Context, c *client.Client, workspaceID, id string, cascade bool, kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	links, err := findDependentLinks(ctx, c, workspaceID, id)
+	if err != nil {
+		if cascade {
+			diags.AddError(fmt.Sprintf("Error deleting %s", kind), fmt.Sprintf("Cannot delete links referencing %s %q: %s", kind, id, err.Error()))
+		} else {
+			diags.AddWarning("Cannot check links", fmt.Sprintf("Cannot check for links referencing %s %q: %s", kind, id, err.Error()))
+		}
+		return diags
+	}
+	if len(links) == 0 {
+		return diags
+	}
+	if !cascade {
+		diags.AddWarning(
+			fmt.Sprintf("Links point at a deleted %s", kind),
+			fmt.Sprintf("%d link(s) referencing %s %q are left pointing at the deleted %s: %s. Delete them, or set "+
+				"cascade_delete_links = true to delete them with the %s.", len(links), kind, id, kind, describeLinks(links), kind),
+		)
+		return diags
+	}
+	for _, l := range links {
+		if err := c.DeleteLink(ctx, workspaceID, l.ID); err != nil {
+			diags.AddError(
+				fmt.Sprintf("Error deleting %s", kind),
+				fmt.Sprintf("Cannot delete link %s referencing %s %q: %s", l, kind, id, err.Error()),
+			)
+			return diags
+		}
+	}
+	return diags
+}
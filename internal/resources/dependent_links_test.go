@@ -0,0 +1,178 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testLinks is the link list served by linkServer and deleteServer.
+const testLinks = `{"links": [
+	{"id": "link-1", "fromId": "stream-id", "toId": "destination-id"},
+	{"id": "link-2", "fromId": "other-stream", "toId": "destination-id"},
+	{"id": "link-3", "fromId": "stream-id", "toId": "other-destination", "deleted": true},
+	{"id": "link-4", "fromId": "other-stream", "toId": "other-destination"}
+]}`
+
+// linkServer serves a fixed link list and records the links deleted through it.
+func linkServer(t *testing.T, deleted *[]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(testLinks))
+		case http.MethodDelete:
+			*deleted = append(*deleted, r.URL.Query().Get("id"))
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFindDependentLinks(t *testing.T) {
+	ctx := context.Background()
+	var deleted []string
+	c := client.New(linkServer(t, &deleted).URL, "token", "", "test")
+
+	links, err := findDependentLinks(ctx, c, "workspace-id", "destination-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []dependentLink{
+		{ID: "link-1", FromID: "stream-id", ToID: "destination-id"},
+		{ID: "link-2", FromID: "other-stream", ToID: "destination-id"},
+	}
+	if !reflect.DeepEqual(links, want) {
+		t.Fatalf("links mismatch:\n got: %#v\nwant: %#v", links, want)
+	}
+
+	// Soft-deleted links are ignored.
+	links, err = findDependentLinks(ctx, c, "workspace-id", "stream-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(links) != 1 || links[0].ID != "link-1" {
+		t.Fatalf("expected only link-1, got %#v", links)
+	}
+}
+
+func TestDeleteDependentLinks(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("warns without cascade", func(t *testing.T) {
+		var deleted []string
+		c := client.New(linkServer(t, &deleted).URL, "token", "", "test")
+
+		diags := deleteDependentLinks(ctx, c, "workspace-id", "destination-id", false, "destination")
+		if diags.HasError() || diags.WarningsCount() != 1 {
+			t.Fatalf("expected one warning, got %v", diags)
+		}
+		if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, "link-1") || !strings.Contains(detail, "link-2") {
+			t.Fatalf("warning should list the links, got %q", detail)
+		}
+		if len(deleted) != 0 {
+			t.Fatalf("no links should be deleted, deleted %v", deleted)
+		}
+	})
+
+	t.Run("deletes with cascade", func(t *testing.T) {
+		var deleted []string
+		c := client.New(linkServer(t, &deleted).URL, "token", "", "test")
+
+		diags := deleteDependentLinks(ctx, c, "workspace-id", "destination-id", true, "destination")
+		if diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if want := []string{"link-1", "link-2"}; !reflect.DeepEqual(deleted, want) {
+			t.Fatalf("deleted links mismatch: got %v, want %v", deleted, want)
+		}
+	})
+}
+
+// deleteServer serves testLinks and records every DELETE as the
+// deleted object or link ID. Deleting failID returns a server error.
+func deleteServer(t *testing.T, deleted *[]string, failID string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			_, _ = w.Write([]byte(testLinks))
+			return
+		}
+		id := r.URL.Query().Get("id")
+		if id == "" {
+			id = path.Base(r.URL.Path)
+		}
+		if id == failID {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		*deleted = append(*deleted, id)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestDestinationDelete_CascadeOrder(t *testing.T) {
+	ctx := context.Background()
+	s := destinationSchema(t, ctx)
+
+	tests := map[string]struct {
+		failID      string
+		wantDeleted []string
+		wantError   string
+	}{
+		"deletes links then destination": {wantDeleted: []string{"link-1", "link-2", "destination-id"}},
+		"keeps destination when a link delete fails": {
+			failID:      "link-2",
+			wantDeleted: []string{"link-1"},
+			wantError:   "Error deleting destination",
+		},
+		"reports a failed destination delete": {
+			failID:      "destination-id",
+			wantDeleted: []string{"link-1", "link-2"},
+			wantError:   "Error deleting destination",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var deleted []string
+			r := &destinationResource{client: client.New(deleteServer(t, &deleted, tc.failID).URL, "token", "", "test")}
+
+			m := newDestinationState("workspace-id", "destination-id")
+			m.Name = types.StringValue("Warehouse")
+			m.DestinationType = types.StringValue("clickhouse")
+			m.CascadeDeleteLinks = types.BoolValue(true)
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := state.Set(ctx, m); diags.HasError() {
+				t.Fatalf("unexpected diagnostics building state: %v", diags)
+			}
+			resp := resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+
+			if !reflect.DeepEqual(deleted, tc.wantDeleted) {
+				t.Fatalf("deleted mismatch: got %v, want %v", deleted, tc.wantDeleted)
+			}
+			if tc.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tc.wantError {
+				t.Fatalf("expected %q, got %v", tc.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
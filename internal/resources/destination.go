@@ -3,6 +3,8 @@ package resources
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,12 +16,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
 	_ resource.Resource                   = &destinationResource{}
 	_ resource.ResourceWithImportState    = &destinationResource{}
+	_ resource.ResourceWithIdentity       = &destinationResource{}
 	_ resource.ResourceWithValidateConfig = &destinationResource{}
+	_ resource.ResourceWithModifyPlan     = &destinationResource{}
+	_ resource.ResourceWithUpgradeState   = &destinationResource{}
 )
 
 type destinationResource struct {
@@ -28,47 +34,195 @@ type destinationResource struct {
 
 // Typed models for extracting nested object values via As().
 type clickhouseModel struct {
-	Protocol types.String `tfsdk:"protocol"`
-	Hosts    types.List   `tfsdk:"hosts"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Database types.String `tfsdk:"database"`
-	Cluster  types.String `tfsdk:"cluster"`
+	Protocol          types.String `tfsdk:"protocol"`
+	Hosts             types.List   `tfsdk:"hosts"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	PasswordFromEnv   types.String `tfsdk:"password_from_env"`
+	PasswordFile      types.String `tfsdk:"password_file"`
+	PasswordHash      types.String `tfsdk:"password_hash"`
+	Database          types.String `tfsdk:"database"`
+	Cluster           types.String `tfsdk:"cluster"`
+	Parameters        types.Map    `tfsdk:"parameters"`
+	LoadAsJSON        types.Bool   `tfsdk:"load_as_json"`
+	CACertificate     types.String `tfsdk:"ca_certificate"`
+	Engine            types.Object `tfsdk:"engine"`
+	SSHTunnel         types.Object `tfsdk:"ssh_tunnel"`
 }
 
 type bigqueryModel struct {
-	Credentials types.String `tfsdk:"credentials"`
-	ProjectID   types.String `tfsdk:"project_id"`
-	BQDataset   types.String `tfsdk:"bq_dataset"`
+	Credentials             types.String `tfsdk:"credentials"`
+	CredentialsWO           types.String `tfsdk:"credentials_wo"`
+	CredentialsWOVersion    types.Int64  `tfsdk:"credentials_wo_version"`
+	CredentialsFromEnv      types.String `tfsdk:"credentials_from_env"`
+	CredentialsFile         types.String `tfsdk:"credentials_file"`
+	CredentialsHash         types.String `tfsdk:"credentials_hash"`
+	ProjectID               types.String `tfsdk:"project_id"`
+	BQDataset               types.String `tfsdk:"bq_dataset"`
+	Location                types.String `tfsdk:"location"`
+	PartitionExpirationDays types.Int64  `tfsdk:"partition_expiration_days"`
+	TablePrefix             types.String `tfsdk:"table_prefix"`
+	TableSuffix             types.String `tfsdk:"table_suffix"`
 }
 
 // Attribute type maps for constructing types.Object values.
-var clickhouseAttrTypes = map[string]attr.Type{
-	"protocol": types.StringType,
-	"hosts":    types.ListType{ElemType: types.StringType},
-	"username": types.StringType,
-	"password": types.StringType,
-	"database": types.StringType,
-	"cluster":  types.StringType,
-}
+var clickhouseAttrTypes = withSecretRefTypes(map[string]attr.Type{
+	"protocol":            types.StringType,
+	"hosts":               types.ListType{ElemType: types.StringType},
+	"username":            types.StringType,
+	"password":            types.StringType,
+	"password_wo":         types.StringType,
+	"password_wo_version": types.Int64Type,
+	"database":            types.StringType,
+	"cluster":             types.StringType,
+	"parameters":          types.MapType{ElemType: types.StringType},
+	"load_as_json":        types.BoolType,
+	"ca_certificate":      types.StringType,
+	"engine":              types.ObjectType{AttrTypes: clickhouseEngineAttrTypes},
+	"ssh_tunnel":          types.ObjectType{AttrTypes: sshTunnelAttrTypes},
+}, "password")
+
+var bigqueryAttrTypes = withSecretRefTypes(map[string]attr.Type{
+	"credentials":               types.StringType,
+	"credentials_wo":            types.StringType,
+	"credentials_wo_version":    types.Int64Type,
+	"project_id":                types.StringType,
+	"bq_dataset":                types.StringType,
+	"location":                  types.StringType,
+	"partition_expiration_days": types.Int64Type,
+	"table_prefix":              types.StringType,
+	"table_suffix":              types.StringType,
+}, "credentials")
 
-var bigqueryAttrTypes = map[string]attr.Type{
-	"credentials": types.StringType,
-	"project_id":  types.StringType,
-	"bq_dataset":  types.StringType,
+// destinationBlocks maps destination types to the nested block that configures
+// them. Every other destination type is configured through config and
+// secret_config, which may also be used instead of a block.
+var destinationBlocks = map[string]string{
+	"clickhouse": "clickhouse",
+	"bigquery":   "bigquery",
+	"postgres":   "postgres",
+	"snowflake":  "snowflake",
+	"redshift":   "redshift",
+	"s3":         "s3",
+	"gcs":        "gcs",
+	"webhook":    "webhook",
+	"mixpanel":   "mixpanel",
+	"amplitude":  "amplitude",
+	"posthog":    "posthog",
+	"ga4":        "ga4",
+	"mysql":      "mysql",
 }
 
+// destinationSystemFields are set by the provider or Console rather than being
+// part of a destination's settings.
+var destinationSystemFields = append([]string{"name", "destinationType"}, systemFields...)
+
 // destinationModel uses types.Object for nested attributes so the framework
 // can handle null, unknown, and concrete values at all lifecycle stages
-// (validate, plan, apply). Use clickhouse() / bigquery() to extract the
+// (validate, plan, apply). Use clickhouse() / bigquery() / postgres() to extract the
 // typed models when values are known.
 type destinationModel struct {
-	WorkspaceID     types.String `tfsdk:"workspace_id"`
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	DestinationType types.String `tfsdk:"destination_type"`
-	ClickHouse      types.Object `tfsdk:"clickhouse"`
-	BigQuery        types.Object `tfsdk:"bigquery"`
+	WorkspaceID        types.String `tfsdk:"workspace_id"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	DestinationType    types.String `tfsdk:"destination_type"`
+	VerifyConnection   types.Bool   `tfsdk:"verify_connection"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	CascadeDeleteLinks types.Bool   `tfsdk:"cascade_delete_links"`
+	ClickHouse         types.Object `tfsdk:"clickhouse"`
+	BigQuery           types.Object `tfsdk:"bigquery"`
+	Postgres           types.Object `tfsdk:"postgres"`
+	Snowflake          types.Object `tfsdk:"snowflake"`
+	Redshift           types.Object `tfsdk:"redshift"`
+	S3                 types.Object `tfsdk:"s3"`
+	GCS                types.Object `tfsdk:"gcs"`
+	Webhook            types.Object `tfsdk:"webhook"`
+	Mixpanel           types.Object `tfsdk:"mixpanel"`
+	Amplitude          types.Object `tfsdk:"amplitude"`
+	PostHog            types.Object `tfsdk:"posthog"`
+	GA4                types.Object `tfsdk:"ga4"`
+	MySQL              types.Object `tfsdk:"mysql"`
+	Config             types.String `tfsdk:"config"`
+	SecretConfig       types.String `tfsdk:"secret_config"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+	LastAppliedAt      types.String `tfsdk:"last_applied_at"`
+}
+
+// newDestinationState returns an empty state for the given destination, with
+// every nested block null.
+func newDestinationState(workspaceID, id string) *destinationModel {
+	state := &destinationModel{
+		WorkspaceID:        types.StringValue(workspaceID),
+		ID:                 types.StringValue(id),
+		DeletionProtection: types.BoolValue(false),
+		CascadeDeleteLinks: types.BoolValue(false),
+	}
+	state.clearBlocks()
+	return state
+}
+
+// blocks returns the nested destination blocks keyed by attribute name.
+func (m *destinationModel) blocks() map[string]types.Object {
+	return map[string]types.Object{
+		"clickhouse": m.ClickHouse,
+		"bigquery":   m.BigQuery,
+		"postgres":   m.Postgres,
+		"snowflake":  m.Snowflake,
+		"redshift":   m.Redshift,
+		"s3":         m.S3,
+		"gcs":        m.GCS,
+		"webhook":    m.Webhook,
+		"mixpanel":   m.Mixpanel,
+		"amplitude":  m.Amplitude,
+		"posthog":    m.PostHog,
+		"ga4":        m.GA4,
+		"mysql":      m.MySQL,
+	}
+}
+
+// clearBlocks sets every nested destination block to null.
+func (m *destinationModel) clearBlocks() {
+	m.ClickHouse = types.ObjectNull(clickhouseAttrTypes)
+	m.BigQuery = types.ObjectNull(bigqueryAttrTypes)
+	m.Postgres = types.ObjectNull(postgresAttrTypes)
+	m.Snowflake = types.ObjectNull(snowflakeAttrTypes)
+	m.Redshift = types.ObjectNull(redshiftAttrTypes)
+	m.S3 = types.ObjectNull(s3AttrTypes)
+	m.GCS = types.ObjectNull(gcsAttrTypes)
+	m.Webhook = types.ObjectNull(webhookAttrTypes)
+	m.Mixpanel = types.ObjectNull(mixpanelAttrTypes)
+	m.Amplitude = types.ObjectNull(amplitudeAttrTypes)
+	m.PostHog = types.ObjectNull(posthogAttrTypes)
+	m.GA4 = types.ObjectNull(ga4AttrTypes)
+	m.MySQL = types.ObjectNull(mysqlAttrTypes)
+}
+
+// setBlocks returns the sorted names of the nested blocks that are definitively set.
+func (m *destinationModel) setBlocks() []string {
+	var names []string
+	for name, obj := range m.blocks() {
+		if !obj.IsNull() && !obj.IsUnknown() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isGeneric reports whether the destination is configured through config and
+// secret_config rather than a nested block. Unknown values count as set.
+func (m *destinationModel) isGeneric() bool {
+	return !m.Config.IsNull() || !m.SecretConfig.IsNull()
+}
+
+// destinationTypeLabel returns how destination_type is named in diagnostics.
+func destinationTypeLabel(destType string) string {
+	if destType == "bigquery" {
+		return "BigQuery"
+	}
+	return fmt.Sprintf("%q", destType)
 }
 
 func (m *destinationModel) clickhouse(ctx context.Context) (*clickhouseModel, diag.Diagnostics) {
@@ -99,7 +253,9 @@ func (r *destinationResource) Metadata(_ context.Context, req resource.MetadataR
 
 func (r *destinationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Jitsu destination (e.g., ClickHouse, BigQuery).",
+		Version: 1,
+		Description: "Manages a Jitsu destination (e.g., ClickHouse, BigQuery). Destination types without a dedicated " +
+			"block are configured through config and secret_config.",
 		Attributes: map[string]schema.Attribute{
 			"workspace_id": schema.StringAttribute{
 				Required:    true,
@@ -121,12 +277,44 @@ func (r *destinationResource) Schema(_ context.Context, _ resource.SchemaRequest
 			},
 			"destination_type": schema.StringAttribute{
 				Required:    true,
-				Description: "Destination type (e.g., clickhouse, bigquery).",
+				Description: "Destination type (e.g., clickhouse, bigquery, postgres, webhook). Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"verify_connection": schema.BoolAttribute{
+				Optional: true,
+				Description: "Run Console's connection check with the new settings before creating or updating the destination. " +
+					"If the check fails, the destination is left unchanged and the apply fails.",
+			},
+			"deletion_protection":  deletionProtectionAttribute(false, "destination"),
+			"cascade_delete_links": cascadeDeleteLinksAttribute("destination"),
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "When Console last modified the destination.",
+			},
+			"last_applied_at": schema.StringAttribute{
+				Computed: true,
+				Description: "Console's updated_at right after Terraform last wrote the destination. Console masks secrets, " +
+					"so when updated_at moves past this value the next plan re-sends every setting, including secrets.",
+			},
+			"config": schema.StringAttribute{
+				Optional: true,
+				Description: "JSON-encoded destination settings (use jsonencode), sent to Console as-is. Works with any " +
+					"destination_type and cannot be combined with a destination block. Formatting and key order " +
+					"differences from Console's copy are not reported as drift.",
+			},
+			"secret_config": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "JSON-encoded secret destination settings merged into config at apply time. Console returns " +
+					"masked values for secrets, so these are preserved from state on read. Keys must not overlap with config " +
+					"or set the keys config cannot set.",
 			},
 			"clickhouse": schema.SingleNestedAttribute{
 				Optional:    true,
 				Description: "ClickHouse destination configuration.",
-				Attributes: map[string]schema.Attribute{
+				Attributes: withSecretRef(map[string]schema.Attribute{
 					"protocol": schema.StringAttribute{
 						Optional:    true,
 						Description: "Connection protocol (e.g., http, https, tcp).",
@@ -143,7 +331,17 @@ func (r *destinationResource) Schema(_ context.Context, _ resource.SchemaRequest
 					"password": schema.StringAttribute{
 						Optional:    true,
 						Sensitive:   true,
-						Description: "Database password. API returns masked value; stored in state from user config.",
+						Description: "Database password. API returns masked value; stored in state from user config. Conflicts with password_wo, password_from_env and password_file.",
+					},
+					"password_wo": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "Write-only database password, never stored in state. Requires Terraform 1.11+. Conflicts with password.",
+					},
+					"password_wo_version": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Change this value to send a new password_wo to Console. password_wo is only sent on create, when this value changes, and after the destination changed outside Terraform.",
 					},
 					"database": schema.StringAttribute{
 						Optional:    true,
@@ -153,16 +351,41 @@ func (r *destinationResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Optional:    true,
 						Description: "ClickHouse cluster name. When set, Bulker creates tables with Replicated* engines for cross-replica data replication.",
 					},
-				},
+					"parameters": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Extra connection parameters (e.g., secure, skip_verify, dial_timeout).",
+					},
+					"load_as_json": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Load events into a single JSON column instead of flattening them into typed columns.",
+					},
+					"ca_certificate": schema.StringAttribute{
+						Optional:    true,
+						Description: "PEM-encoded CA certificate used to verify the server's TLS certificate.",
+					},
+					"engine":     clickhouseEngineSchemaAttribute(),
+					"ssh_tunnel": sshTunnelSchemaAttribute(),
+				}, "password", "database password"),
 			},
 			"bigquery": schema.SingleNestedAttribute{
 				Optional:    true,
 				Description: "BigQuery destination configuration.",
-				Attributes: map[string]schema.Attribute{
+				Attributes: withSecretRef(map[string]schema.Attribute{
 					"credentials": schema.StringAttribute{
-						Required:    true,
+						Optional:    true,
 						Sensitive:   true,
-						Description: "BigQuery service account JSON key.",
+						Description: "BigQuery service account JSON key. Exactly one of credentials, credentials_wo, credentials_from_env or credentials_file must be set.",
+					},
+					"credentials_wo": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						WriteOnly:   true,
+						Description: "Write-only BigQuery service account JSON key, never stored in state. Requires Terraform 1.11+.",
+					},
+					"credentials_wo_version": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Change this value to send new credentials_wo to Console. credentials_wo is only sent on create, when this value changes, and after the destination changed outside Terraform.",
 					},
 					"project_id": schema.StringAttribute{
 						Required:    true,
@@ -172,12 +395,43 @@ func (r *destinationResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Required:    true,
 						Description: "BigQuery dataset name.",
 					},
-				},
+					"location": schema.StringAttribute{
+						Optional:    true,
+						Description: "Dataset location (e.g., US, EU, europe-west1). Console defaults to US.",
+					},
+					"partition_expiration_days": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Days after which table partitions are deleted. Partitions never expire when unset.",
+					},
+					"table_prefix": schema.StringAttribute{
+						Optional:    true,
+						Description: "Prefix added to every table name. Letters, digits and underscores only.",
+					},
+					"table_suffix": schema.StringAttribute{
+						Optional:    true,
+						Description: "Suffix added to every table name. Letters, digits and underscores only.",
+					},
+				}, "credentials", "service account JSON key"),
 			},
+			"postgres":  postgresSchemaAttribute(),
+			"snowflake": snowflakeSchemaAttribute(),
+			"redshift":  redshiftSchemaAttribute(),
+			"s3":        s3SchemaAttribute(),
+			"gcs":       gcsSchemaAttribute(),
+			"webhook":   webhookSchemaAttribute(),
+			"mixpanel":  mixpanelSchemaAttribute(),
+			"amplitude": amplitudeSchemaAttribute(),
+			"posthog":   posthogSchemaAttribute(),
+			"ga4":       ga4SchemaAttribute(),
+			"mysql":     mysqlSchemaAttribute(),
 		},
 	}
 }
 
+func (r *destinationResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = objectIdentitySchema("Destination ID.")
+}
+
 func (r *destinationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.client = configureClient(req, resp)
 }
@@ -193,17 +447,143 @@ func (r *destinationResource) ValidateConfig(ctx context.Context, req resource.V
 	// definitively absent (null), or unknown. We only skip individual
 	// checks that depend on an unknown value — everything else is still
 	// validated at plan time.
-	chSet := !config.ClickHouse.IsNull() && !config.ClickHouse.IsUnknown()
-	chNull := config.ClickHouse.IsNull()
-	bqSet := !config.BigQuery.IsNull() && !config.BigQuery.IsUnknown()
-	bqNull := config.BigQuery.IsNull()
+	blocks := config.blocks()
+	setBlocks := config.setBlocks()
 
-	// Both blocks set is always invalid regardless of destination_type.
-	if chSet && bqSet {
+	// More than one block set is always invalid regardless of destination_type.
+	if len(setBlocks) > 1 {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("bigquery"),
+			path.Root(setBlocks[len(setBlocks)-1]),
 			"Invalid destination configuration",
-			"Only one destination config block may be set. Choose either clickhouse or bigquery to match destination_type.",
+			fmt.Sprintf("Only one destination config block may be set, got %s. Choose the block matching destination_type.",
+				strings.Join(setBlocks, ", ")),
+		)
+	}
+
+	if !config.ClickHouse.IsNull() && !config.ClickHouse.IsUnknown() {
+		ch, d := config.clickhouse(ctx)
+		resp.Diagnostics.Append(d...)
+		if ch != nil {
+			resp.Diagnostics.Append(validateClickhouseOptions(ctx, ch)...)
+			resp.Diagnostics.Append(validateSSHTunnel(ctx, path.Root("clickhouse"), ch.SSHTunnel)...)
+		}
+	}
+	if !config.BigQuery.IsNull() && !config.BigQuery.IsUnknown() {
+		bq, d := config.bigquery(ctx)
+		resp.Diagnostics.Append(d...)
+		if bq != nil && bq.Credentials.IsNull() && bq.CredentialsWO.IsNull() && !secretRefSet(config.BigQuery, "credentials") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("bigquery").AtName("credentials"),
+				"Missing credentials",
+				"BigQuery destinations must set credentials, credentials_wo, credentials_from_env or credentials_file.",
+			)
+		}
+		if bq != nil {
+			resp.Diagnostics.Append(validateBigqueryOptions(bq)...)
+		}
+	}
+
+	if !config.Postgres.IsNull() && !config.Postgres.IsUnknown() {
+		pg, d := config.postgres(ctx)
+		resp.Diagnostics.Append(d...)
+		if pg != nil {
+			resp.Diagnostics.Append(validatePostgres(pg)...)
+			resp.Diagnostics.Append(validateSSHTunnel(ctx, path.Root("postgres"), pg.SSHTunnel)...)
+		}
+	}
+
+	if !config.Snowflake.IsNull() && !config.Snowflake.IsUnknown() {
+		sf, d := config.snowflake(ctx)
+		resp.Diagnostics.Append(d...)
+		if sf != nil {
+			resp.Diagnostics.Append(validateSnowflake(sf)...)
+		}
+	}
+
+	if !config.Redshift.IsNull() && !config.Redshift.IsUnknown() {
+		rs, d := config.redshift(ctx)
+		resp.Diagnostics.Append(d...)
+		if rs != nil {
+			resp.Diagnostics.Append(validateRedshift(rs)...)
+			resp.Diagnostics.Append(validateSSHTunnel(ctx, path.Root("redshift"), rs.SSHTunnel)...)
+		}
+	}
+
+	if !config.S3.IsNull() && !config.S3.IsUnknown() {
+		s3Cfg, d := config.s3(ctx)
+		resp.Diagnostics.Append(d...)
+		if s3Cfg != nil {
+			resp.Diagnostics.Append(validateS3(s3Cfg)...)
+		}
+	}
+
+	if !config.GCS.IsNull() && !config.GCS.IsUnknown() {
+		gcsCfg, d := config.gcs(ctx)
+		resp.Diagnostics.Append(d...)
+		if gcsCfg != nil {
+			resp.Diagnostics.Append(validateGCS(gcsCfg)...)
+		}
+	}
+
+	if !config.Webhook.IsNull() && !config.Webhook.IsUnknown() {
+		wh, d := config.webhook(ctx)
+		resp.Diagnostics.Append(d...)
+		if wh != nil {
+			resp.Diagnostics.Append(validateWebhook(ctx, wh)...)
+		}
+	}
+
+	if !config.Mixpanel.IsNull() && !config.Mixpanel.IsUnknown() {
+		mp, d := config.mixpanel(ctx)
+		resp.Diagnostics.Append(d...)
+		if mp != nil {
+			resp.Diagnostics.Append(validateMixpanel(mp)...)
+		}
+	}
+
+	if !config.Amplitude.IsNull() && !config.Amplitude.IsUnknown() {
+		amp, d := config.amplitude(ctx)
+		resp.Diagnostics.Append(d...)
+		if amp != nil {
+			resp.Diagnostics.Append(validateAmplitude(amp)...)
+		}
+	}
+
+	if !config.PostHog.IsNull() && !config.PostHog.IsUnknown() {
+		ph, d := config.posthog(ctx)
+		resp.Diagnostics.Append(d...)
+		if ph != nil {
+			resp.Diagnostics.Append(validatePosthog(ph)...)
+		}
+	}
+
+	if !config.GA4.IsNull() && !config.GA4.IsUnknown() {
+		ga, d := config.ga4(ctx)
+		resp.Diagnostics.Append(d...)
+		if ga != nil {
+			resp.Diagnostics.Append(validateGa4(ga)...)
+		}
+	}
+
+	if !config.MySQL.IsNull() && !config.MySQL.IsUnknown() {
+		my, d := config.mysql(ctx)
+		resp.Diagnostics.Append(d...)
+		if my != nil {
+			resp.Diagnostics.Append(validateMysql(my)...)
+			resp.Diagnostics.Append(validateSSHTunnel(ctx, path.Root("mysql"), my.SSHTunnel)...)
+		}
+	}
+
+	for _, ref := range destinationSecretRefs {
+		resp.Diagnostics.Append(validateSecretRefConflicts(path.Root(ref.block), blocks[ref.block], ref.name)...)
+	}
+
+	resp.Diagnostics.Append(validateJSONConfig(config.Config, config.SecretConfig, "config", "secret_config", destinationSystemFields)...)
+	if config.isGeneric() && len(setBlocks) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config"),
+			"Invalid destination configuration",
+			fmt.Sprintf("config and secret_config cannot be combined with the %s block.", setBlocks[0]),
 		)
 	}
 
@@ -212,43 +592,174 @@ func (r *destinationResource) ValidateConfig(ctx context.Context, req resource.V
 		return
 	}
 
-	isBigQuery := config.DestinationType.ValueString() == "bigquery"
+	destType := config.DestinationType.ValueString()
+	expected := destinationBlocks[destType]
+	label := destinationTypeLabel(destType)
+
+	// Extra blocks — flag when definitively set (not unknown).
+	for _, name := range setBlocks {
+		if name != expected {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid destination configuration",
+				fmt.Sprintf("%s destinations cannot define the %s block.", label, name),
+			)
+		}
+	}
 
-	if isBigQuery {
-		// Missing bigquery block — flag when definitively null (not unknown).
-		if bqNull {
+	// Missing configuration — flag when definitively null (not unknown).
+	if config.Config.IsNull() && config.SecretConfig.IsNull() {
+		if expected != "" && blocks[expected].IsNull() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("bigquery"),
+				path.Root(expected),
 				"Invalid destination configuration",
-				"BigQuery destinations must define the bigquery block.",
+				fmt.Sprintf("%s destinations must define the %s block. Alternatively, set config.", label, expected),
 			)
 		}
-		// Extra clickhouse block — flag when definitively set (not unknown).
-		if chSet {
+		if expected == "" && len(setBlocks) == 0 {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("clickhouse"),
+				path.Root("config"),
 				"Invalid destination configuration",
-				"BigQuery destinations cannot define the clickhouse block.",
+				fmt.Sprintf("%s destinations have no dedicated block and must set config.", label),
 			)
 		}
+	}
+}
+
+// ModifyPlan warns about links that would point at a destroyed destination,
+// records the hashes of secret references, and warns when a destination_type
+// change replaces the destination, because Console links that point at the
+// old destination are not recreated.
+func (r *destinationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !r.planTypeChange(ctx, req, resp) {
+		destroys, diags := planDestroys(ctx, req, "workspace_id", "id", "destination_type")
+		resp.Diagnostics.Append(diags...)
+		if destroys {
+			planDependentLinks(ctx, r.client, req, resp, "destination")
+		}
+	}
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	// Non-bigquery: missing clickhouse block — flag when definitively null.
-	if chNull {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("clickhouse"),
-			"Invalid destination configuration",
-			fmt.Sprintf("%q destinations must define the clickhouse block.", config.DestinationType.ValueString()),
-		)
+	r.planSecretRefs(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
 	}
-	// Non-bigquery: extra bigquery block — flag when definitively set.
-	if bqSet {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("bigquery"),
-			"Invalid destination configuration",
-			fmt.Sprintf("%q destinations cannot define the bigquery block.", config.DestinationType.ValueString()),
+
+	r.planOutOfBandChange(ctx, req, resp)
+}
+
+// planTypeChange warns when a destination_type change replaces the destination
+// under the same ID. Console only lets the ID be reused once no live link
+// references the old destination, so this warning covers the destination's
+// links in place of planDependentLinks. It reports whether the plan is such a
+// replacement.
+func (r *destinationResource) planTypeChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) bool {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return false
+	}
+	var state, plan destinationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DestinationType.IsUnknown() || plan.DestinationType.Equal(state.DestinationType) ||
+		!plan.ID.Equal(state.ID) || !plan.WorkspaceID.Equal(state.WorkspaceID) {
+		return false
+	}
+
+	id := state.ID.ValueString()
+	detail := fmt.Sprintf("Changing destination_type from %q to %q deletes destination %q and creates it again with the same ID. ",
+		state.DestinationType.ValueString(), plan.DestinationType.ValueString(), id)
+	var links []dependentLink
+	var err error
+	if r.client != nil {
+		links, err = findDependentLinks(ctx, r.client, state.WorkspaceID.ValueString(), id)
+	}
+	switch {
+	case err != nil:
+		detail += fmt.Sprintf("Cannot check for links referencing it: %s. ", err.Error())
+	case len(links) > 0 && state.CascadeDeleteLinks.ValueBool():
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("destination_type"),
+			"Destination will be replaced",
+			detail+fmt.Sprintf("Because cascade_delete_links is true, these links are deleted with the old destination: %s. "+
+				"To keep them, add the destination's destination_type to lifecycle.replace_triggered_by of the jitsu_link "+
+				"resources with to_id = %q so they are recreated with it.", describeLinks(links), id),
 		)
+		return true
+	case len(links) > 0:
+		detail += fmt.Sprintf("Live links reference it: %s. ", describeLinks(links))
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("destination_type"),
+		"Destination will be replaced",
+		detail+fmt.Sprintf("The replacement fails while a live link still references the old destination, after the old "+
+			"destination has already been deleted. Add the destination's destination_type to lifecycle.replace_triggered_by "+
+			"of the jitsu_link resources with to_id = %q so they are replaced along with it, or set cascade_delete_links = true "+
+			"and apply before changing destination_type.", id),
+	)
+	return true
+}
+
+// changedOutOfBand reports whether Console recorded a change to the destination
+// after Terraform last applied it.
+func (m *destinationModel) changedOutOfBand() bool {
+	return !m.UpdatedAt.IsNull() && !m.LastAppliedAt.IsNull() && !m.UpdatedAt.Equal(m.LastAppliedAt)
+}
+
+// planOutOfBandChange plans an update when the destination changed in Console
+// since Terraform last wrote it. Console masks secrets, so a rotated password
+// only shows up as a newer updatedAt; re-sending the configuration, write-only
+// secrets included, restores the secrets Terraform manages.
+func (r *destinationResource) planOutOfBandChange(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var updatedAt, lastAppliedAt types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("updated_at"), &updatedAt)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("last_applied_at"), &lastAppliedAt)...)
+	if resp.Diagnostics.HasError() || updatedAt.IsNull() || lastAppliedAt.IsNull() || updatedAt.Equal(lastAppliedAt) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("updated_at"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_applied_at"), types.StringUnknown())...)
+	resp.Diagnostics.AddWarning(
+		"Destination changed outside Terraform",
+		fmt.Sprintf("Destination was modified in Console at %s, after Terraform last applied it at %s. Console masks "+
+			"secrets, so changes to them cannot be detected; all settings, including secrets, will be sent again.",
+			updatedAt.ValueString(), lastAppliedAt.ValueString()),
+	)
+}
+
+// planSecretRefs resolves every secret reference in the plan and plans its
+// hash, so a changed environment variable or file plans an update. The hash in
+// state is kept while the secret still matches it.
+func (r *destinationResource) planSecretRefs(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan destinationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	blocks := plan.blocks()
+	for _, ref := range destinationSecretRefs {
+		obj := blocks[ref.block]
+		if obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		p := path.Root(ref.block)
+		fromEnv, file, _ := objectSecretRef(obj, ref.name)
+		refPath := p.AtName(ref.name + "_from_env")
+		if fromEnv.IsNull() {
+			refPath = p.AtName(ref.name + "_file")
+		}
+		prior := types.StringNull()
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, p.AtName(ref.name+"_hash"), &prior)...)
+		}
+		value, hash, d := planSecretValue(refPath, fromEnv, file, prior)
+		resp.Diagnostics.Append(d...)
+		if ref.validate != nil && !value.IsNull() {
+			resp.Diagnostics.Append(ref.validate(refPath, value, obj)...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p.AtName(ref.name+"_hash"), hash)...)
 	}
 }
 
@@ -264,18 +775,23 @@ func (r *destinationResource) buildPayload(ctx context.Context, plan *destinatio
 	if diags.HasError() {
 		return nil, fmt.Errorf("reading bigquery config: %v", diags.Errors())
 	}
-	isBigQuery := plan.DestinationType.ValueString() == "bigquery"
-	if isBigQuery && bq == nil {
-		return nil, fmt.Errorf("BigQuery destinations must define the bigquery block")
-	}
-	if isBigQuery && ch != nil {
-		return nil, fmt.Errorf("BigQuery destinations cannot define the clickhouse block")
+	destType := plan.DestinationType.ValueString()
+	expected := destinationBlocks[destType]
+	label := destinationTypeLabel(destType)
+	generic := plan.isGeneric()
+	for _, name := range plan.setBlocks() {
+		if name != expected {
+			return nil, fmt.Errorf("%s destinations cannot define the %s block", label, name)
+		}
+		if generic {
+			return nil, fmt.Errorf("config and secret_config cannot be combined with the %s block", name)
+		}
 	}
-	if !isBigQuery && ch == nil {
-		return nil, fmt.Errorf("%q destinations must define the clickhouse block", plan.DestinationType.ValueString())
+	if !generic && expected != "" && plan.blocks()[expected].IsNull() {
+		return nil, fmt.Errorf("%s destinations must define the %s block", label, expected)
 	}
-	if !isBigQuery && bq != nil {
-		return nil, fmt.Errorf("%q destinations cannot define the bigquery block", plan.DestinationType.ValueString())
+	if !generic && expected == "" {
+		return nil, fmt.Errorf("%s destinations must set config", label)
 	}
 
 	payload := map[string]interface{}{
@@ -307,20 +823,271 @@ func (r *destinationResource) buildPayload(ctx context.Context, plan *destinatio
 		if !ch.Cluster.IsNull() && !ch.Cluster.IsUnknown() {
 			payload["cluster"] = ch.Cluster.ValueString()
 		}
+		setPayloadStringMap(payload, "parameters", ch.Parameters)
+		setPayloadBool(payload, "loadAsJson", ch.LoadAsJSON)
+		setPayloadString(payload, "caCertificate", ch.CACertificate)
+		if err := clickhouseEngineToPayload(ctx, ch, payload); err != nil {
+			return nil, err
+		}
+		if err := sshTunnelToPayload(ctx, ch.SSHTunnel, payload); err != nil {
+			return nil, err
+		}
 	}
 
 	if bq != nil {
-		payload["keyFile"] = bq.Credentials.ValueString()
+		if !bq.Credentials.IsNull() && !bq.Credentials.IsUnknown() {
+			payload["keyFile"] = bq.Credentials.ValueString()
+		}
 		payload["project"] = bq.ProjectID.ValueString()
 		payload["bqDataset"] = bq.BQDataset.ValueString()
+		setPayloadString(payload, "location", bq.Location)
+		setPayloadInt64(payload, "partitionExpirationDays", bq.PartitionExpirationDays)
+		setPayloadString(payload, "tablePrefix", bq.TablePrefix)
+		setPayloadString(payload, "tableSuffix", bq.TableSuffix)
+	}
+
+	pg, diags := plan.postgres(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading postgres config: %v", diags.Errors())
+	}
+	if pg != nil {
+		postgresToPayload(pg, payload)
+		if err := sshTunnelToPayload(ctx, pg.SSHTunnel, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	sf, diags := plan.snowflake(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading snowflake config: %v", diags.Errors())
+	}
+	if sf != nil {
+		snowflakeToPayload(sf, payload)
+	}
+
+	rs, diags := plan.redshift(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading redshift config: %v", diags.Errors())
+	}
+	if rs != nil {
+		redshiftToPayload(rs, payload)
+		if err := sshTunnelToPayload(ctx, rs.SSHTunnel, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	s3Cfg, diags := plan.s3(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading s3 config: %v", diags.Errors())
+	}
+	if s3Cfg != nil {
+		s3ToPayload(s3Cfg, payload)
+	}
+
+	gcsCfg, diags := plan.gcs(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading gcs config: %v", diags.Errors())
+	}
+	if gcsCfg != nil {
+		gcsToPayload(gcsCfg, payload)
+	}
+
+	wh, diags := plan.webhook(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading webhook config: %v", diags.Errors())
+	}
+	if wh != nil {
+		if err := webhookToPayload(ctx, wh, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	mp, diags := plan.mixpanel(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading mixpanel config: %v", diags.Errors())
+	}
+	if mp != nil {
+		mixpanelToPayload(mp, payload)
+	}
+
+	amp, diags := plan.amplitude(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading amplitude config: %v", diags.Errors())
+	}
+	if amp != nil {
+		amplitudeToPayload(amp, payload)
+	}
+
+	ph, diags := plan.posthog(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading posthog config: %v", diags.Errors())
+	}
+	if ph != nil {
+		posthogToPayload(ph, payload)
+	}
+
+	ga, diags := plan.ga4(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading ga4 config: %v", diags.Errors())
+	}
+	if ga != nil {
+		ga4ToPayload(ga, payload)
+	}
+
+	my, diags := plan.mysql(ctx)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading mysql config: %v", diags.Errors())
+	}
+	if my != nil {
+		mysqlToPayload(my, payload)
+		if err := sshTunnelToPayload(ctx, my.SSHTunnel, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	if generic {
+		for _, attr := range []types.String{plan.Config, plan.SecretConfig} {
+			if attr.IsNull() || attr.IsUnknown() {
+				continue
+			}
+			settings, err := decodeJSONObject(attr.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("destination settings %w", err)
+			}
+			for k, v := range settings {
+				payload[k] = v
+			}
+		}
 	}
 
 	return payload, nil
 }
 
+// applyWriteOnly copies write-only secrets from config into payload. Write-only
+// values are only available in config; plan and state always hold null. On
+// Update, state is the prior state and a secret is only resent when its
+// *_wo_version changed, it was previously set another way, or the destination
+// changed outside Terraform (see planOutOfBandChange), since a secret rotated in
+// Console cannot be detected; otherwise Console is sent its mask placeholder and
+// keeps the stored secret. state is nil on Create.
+func (r *destinationResource) applyWriteOnly(ctx context.Context, config, state *destinationModel, payload map[string]interface{}) error {
+	outOfBand := state != nil && state.changedOutOfBand()
+	ch, diags := config.clickhouse(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("reading clickhouse config: %v", diags.Errors())
+	}
+	if ch != nil && !ch.PasswordWO.IsNull() && !ch.PasswordWO.IsUnknown() {
+		resend := true
+		if state != nil {
+			prior, diags := state.clickhouse(ctx)
+			if diags.HasError() {
+				return fmt.Errorf("reading clickhouse state: %v", diags.Errors())
+			}
+			resend = prior == nil || outOfBand || resendWriteOnly(ch.PasswordWOVersion, prior.PasswordWOVersion, prior.Password, prior.PasswordHash)
+		}
+		payload["password"] = writeOnlyPayload(ch.PasswordWO, resend)
+	}
+
+	bq, diags := config.bigquery(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("reading bigquery config: %v", diags.Errors())
+	}
+	if bq != nil && !bq.CredentialsWO.IsNull() && !bq.CredentialsWO.IsUnknown() {
+		resend := true
+		if state != nil {
+			prior, diags := state.bigquery(ctx)
+			if diags.HasError() {
+				return fmt.Errorf("reading bigquery state: %v", diags.Errors())
+			}
+			resend = prior == nil || outOfBand || resendWriteOnly(bq.CredentialsWOVersion, prior.CredentialsWOVersion, prior.Credentials, prior.CredentialsHash)
+		}
+		payload["keyFile"] = writeOnlyPayload(bq.CredentialsWO, resend)
+	}
+
+	return nil
+}
+
+// destinationSecretRef is a block secret that can be read from an environment
+// variable or file, and the Console key it is sent as. validate, when set,
+// checks the secret read from a reference the way the block validates the
+// inline attribute; obj holds the block's other attributes.
+type destinationSecretRef struct {
+	block, name, key string
+	validate         func(p path.Path, value types.String, obj types.Object) diag.Diagnostics
+}
+
+var destinationSecretRefs = []destinationSecretRef{
+	{block: "clickhouse", name: "password", key: "password"},
+	{block: "bigquery", name: "credentials", key: "keyFile", validate: func(p path.Path, value types.String, obj types.Object) diag.Diagnostics {
+		projectID, _ := obj.Attributes()["project_id"].(types.String)
+		return validateBigqueryCredentials(p, value, projectID)
+	}},
+	{block: "postgres", name: "password", key: "password"},
+	{block: "snowflake", name: "password", key: "password"},
+	{block: "redshift", name: "password", key: "password"},
+	{block: "mysql", name: "password", key: "password"},
+}
+
+// applySecretRefs resolves secret references into payload. Hashes that were
+// unknown at plan time are filled in on plan, which becomes the new state.
+func (r *destinationResource) applySecretRefs(ctx context.Context, plan *destinationModel, payload map[string]interface{}) error {
+	blocks := plan.blocks()
+	for _, ref := range destinationSecretRefs {
+		obj := blocks[ref.block]
+		if obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		fromEnv, file, hash := objectSecretRef(obj, ref.name)
+		value, ok, err := applySecretRef(ref.block+"."+ref.name, fromEnv, file, hash)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		payload[ref.key] = value
+		if hash.IsUnknown() {
+			h, err := newSecretHash(value)
+			if err != nil {
+				return fmt.Errorf("hashing %s.%s: %w", ref.block, ref.name, err)
+			}
+			attrs := obj.Attributes()
+			attrs[ref.name+"_hash"] = types.StringValue(h)
+			updated, diags := types.ObjectValue(obj.AttributeTypes(ctx), attrs)
+			if diags.HasError() {
+				return fmt.Errorf("setting %s.%s_hash: %v", ref.block, ref.name, diags.Errors())
+			}
+			plan.setBlock(ref.block, updated)
+		}
+	}
+	return nil
+}
+
+// verifyConnection runs Console's connection check against payload when
+// verify_connection is enabled, so settings that cannot connect are never saved.
+// The check cannot use a stored secret, so write-only secrets from config are
+// always included even when the saved payload leaves them out.
+func (r *destinationResource) verifyConnection(ctx context.Context, plan, config *destinationModel, payload map[string]interface{}) error {
+	if !plan.VerifyConnection.ValueBool() {
+		return nil
+	}
+	check := make(map[string]interface{}, len(payload))
+	for k, v := range payload {
+		check[k] = v
+	}
+	if err := r.applyWriteOnly(ctx, config, nil, check); err != nil {
+		return err
+	}
+	tflog.Debug(ctx, "verifying destination connection", map[string]interface{}{
+		"id": plan.ID.ValueString(),
+	})
+	return r.client.TestDestination(ctx, plan.WorkspaceID.ValueString(), check)
+}
+
 func (r *destinationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan destinationModel
+	var plan, config destinationModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -330,19 +1097,54 @@ func (r *destinationResource) Create(ctx context.Context, req resource.CreateReq
 		resp.Diagnostics.AddError("Error building payload", err.Error())
 		return
 	}
+	if err := r.applyWriteOnly(ctx, &config, nil, payload); err != nil {
+		resp.Diagnostics.AddError("Error building payload", err.Error())
+		return
+	}
+	if err := r.applySecretRefs(ctx, &plan, payload); err != nil {
+		resp.Diagnostics.AddError("Error building payload", err.Error())
+		return
+	}
+
+	if err := r.verifyConnection(ctx, &plan, &config, payload); err != nil {
+		resp.Diagnostics.AddError(
+			"Destination connection test failed",
+			fmt.Sprintf("%s. Destination %q was not created.", err.Error(), plan.ID.ValueString()),
+		)
+		return
+	}
 
-	_, err = r.client.Create(ctx, plan.WorkspaceID.ValueString(), "destination", payload)
+	result, err := r.client.Create(ctx, plan.WorkspaceID.ValueString(), "destination", payload)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating destination", err.Error())
 		return
 	}
+	r.setAppliedAt(ctx, &plan, result)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, plan.WorkspaceID, plan.ID)...)
+}
+
+// setAppliedAt records Console's updatedAt after Terraform wrote the
+// destination. result is the write response; when it does not carry updatedAt
+// the destination is read back.
+func (r *destinationResource) setAppliedAt(ctx context.Context, plan *destinationModel, result map[string]interface{}) {
+	updatedAt := resultString(result, "updatedAt")
+	if updatedAt.IsNull() {
+		remote, err := r.client.Read(ctx, plan.WorkspaceID.ValueString(), "destination", plan.ID.ValueString())
+		if err != nil {
+			tflog.Warn(ctx, "cannot read destination updatedAt after write", map[string]interface{}{"error": err.Error()})
+		}
+		updatedAt = resultString(remote, "updatedAt")
+	}
+	plan.UpdatedAt = updatedAt
+	plan.LastAppliedAt = updatedAt
 }
 
 func (r *destinationResource) readAPIIntoState(ctx context.Context, result map[string]interface{}, state *destinationModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
+	state.UpdatedAt = resultString(result, "updatedAt")
 	if v, ok := result["name"].(string); ok {
 		state.Name = types.StringValue(v)
 	}
@@ -352,14 +1154,39 @@ func (r *destinationResource) readAPIIntoState(ctx context.Context, result map[s
 
 	destType, _ := result["destinationType"].(string)
 
-	switch destType {
+	// Destinations managed through config stay that way; types without a
+	// dedicated block can only be read back into config.
+	block := destinationBlocks[destType]
+	if state.isGeneric() || block == "" {
+		config, err := readJSONConfig(result, destinationSystemFields, state.SecretConfig, state.Config)
+		if err != nil {
+			diags.AddError("Error encoding destination config", err.Error())
+		}
+		state.Config = config
+		// SecretConfig: API returns masked values — preserve state value.
+		block = ""
+	} else {
+		state.Config = types.StringNull()
+		state.SecretConfig = types.StringNull()
+	}
+
+	// Only the block matching destination_type is populated. Secrets are
+	// preserved from the previous block values.
+	prev := *state
+	state.clearBlocks()
+
+	switch block {
 	case "bigquery":
 		bq := &bigqueryModel{}
 		// Credentials (keyFile): API returns masked value — preserve state value.
-		oldBQ, d := state.bigquery(ctx)
+		oldBQ, d := prev.bigquery(ctx)
 		diags.Append(d...)
 		if oldBQ != nil {
 			bq.Credentials = oldBQ.Credentials
+			bq.CredentialsWOVersion = oldBQ.CredentialsWOVersion
+			bq.CredentialsFromEnv = oldBQ.CredentialsFromEnv
+			bq.CredentialsFile = oldBQ.CredentialsFile
+			bq.CredentialsHash = oldBQ.CredentialsHash
 		}
 		if v, ok := result["project"].(string); ok {
 			bq.ProjectID = types.StringValue(v)
@@ -367,12 +1194,15 @@ func (r *destinationResource) readAPIIntoState(ctx context.Context, result map[s
 		if v, ok := result["bqDataset"].(string); ok {
 			bq.BQDataset = types.StringValue(v)
 		}
+		bq.Location = resultString(result, "location")
+		bq.PartitionExpirationDays = resultInt64(result, "partitionExpirationDays")
+		bq.TablePrefix = resultString(result, "tablePrefix")
+		bq.TableSuffix = resultString(result, "tableSuffix")
 		objVal, d := types.ObjectValueFrom(ctx, bigqueryAttrTypes, bq)
 		diags.Append(d...)
 		state.BigQuery = objVal
-		state.ClickHouse = types.ObjectNull(clickhouseAttrTypes)
 
-	default:
+	case "clickhouse":
 		ch := &clickhouseModel{}
 		if v, ok := result["protocol"].(string); ok {
 			ch.Protocol = types.StringValue(v)
@@ -402,10 +1232,20 @@ func (r *destinationResource) readAPIIntoState(ctx context.Context, result map[s
 			ch.Username = types.StringNull()
 		}
 		// Password: API returns masked value — preserve state value.
-		oldCH, d := state.clickhouse(ctx)
+		oldCH, d := prev.clickhouse(ctx)
 		diags.Append(d...)
+		prevTunnel := types.ObjectNull(sshTunnelAttrTypes)
+		prevParameters := types.MapNull(types.StringType)
+		prevEngine := types.ObjectNull(clickhouseEngineAttrTypes)
 		if oldCH != nil {
+			prevParameters = oldCH.Parameters
+			prevEngine = oldCH.Engine
 			ch.Password = oldCH.Password
+			ch.PasswordWOVersion = oldCH.PasswordWOVersion
+			ch.PasswordFromEnv = oldCH.PasswordFromEnv
+			ch.PasswordFile = oldCH.PasswordFile
+			ch.PasswordHash = oldCH.PasswordHash
+			prevTunnel = oldCH.SSHTunnel
 		}
 		if v, ok := result["database"].(string); ok {
 			ch.Database = types.StringValue(v)
@@ -417,10 +1257,71 @@ func (r *destinationResource) readAPIIntoState(ctx context.Context, result map[s
 		} else {
 			ch.Cluster = types.StringNull()
 		}
+		ch.Parameters = resultStringMap(result, "parameters", prevParameters)
+		ch.LoadAsJSON = resultBool(result, "loadAsJson")
+		ch.CACertificate = resultString(result, "caCertificate")
+		ch.Engine, d = readClickhouseEngine(ctx, result, prevEngine)
+		diags.Append(d...)
+		ch.SSHTunnel, d = readSSHTunnel(ctx, result, prevTunnel)
+		diags.Append(d...)
 		objVal, d := types.ObjectValueFrom(ctx, clickhouseAttrTypes, ch)
 		diags.Append(d...)
 		state.ClickHouse = objVal
-		state.BigQuery = types.ObjectNull(bigqueryAttrTypes)
+
+	case "postgres":
+		objVal, d := readPostgresBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.Postgres = objVal
+
+	case "snowflake":
+		objVal, d := readSnowflakeBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.Snowflake = objVal
+
+	case "redshift":
+		objVal, d := readRedshiftBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.Redshift = objVal
+
+	case "s3":
+		objVal, d := readS3Block(ctx, result, &prev)
+		diags.Append(d...)
+		state.S3 = objVal
+
+	case "gcs":
+		objVal, d := readGCSBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.GCS = objVal
+
+	case "webhook":
+		objVal, d := readWebhookBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.Webhook = objVal
+
+	case "mixpanel":
+		objVal, d := readMixpanelBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.Mixpanel = objVal
+
+	case "amplitude":
+		objVal, d := readAmplitudeBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.Amplitude = objVal
+
+	case "posthog":
+		objVal, d := readPosthogBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.PostHog = objVal
+
+	case "ga4":
+		objVal, d := readGa4Block(ctx, result, &prev)
+		diags.Append(d...)
+		state.GA4 = objVal
+
+	case "mysql":
+		objVal, d := readMysqlBlock(ctx, result, &prev)
+		diags.Append(d...)
+		state.MySQL = objVal
 	}
 
 	return diags
@@ -445,11 +1346,14 @@ func (r *destinationResource) Read(ctx context.Context, req resource.ReadRequest
 
 	resp.Diagnostics.Append(r.readAPIIntoState(ctx, result, &state)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
 }
 
 func (r *destinationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan destinationModel
+	var plan, config, state destinationModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -459,14 +1363,32 @@ func (r *destinationResource) Update(ctx context.Context, req resource.UpdateReq
 		resp.Diagnostics.AddError("Error building payload", err.Error())
 		return
 	}
+	if err := r.applyWriteOnly(ctx, &config, &state, payload); err != nil {
+		resp.Diagnostics.AddError("Error building payload", err.Error())
+		return
+	}
+	if err := r.applySecretRefs(ctx, &plan, payload); err != nil {
+		resp.Diagnostics.AddError("Error building payload", err.Error())
+		return
+	}
+
+	if err := r.verifyConnection(ctx, &plan, &config, payload); err != nil {
+		resp.Diagnostics.AddError(
+			"Destination connection test failed",
+			fmt.Sprintf("%s. Destination %q was left unchanged.", err.Error(), plan.ID.ValueString()),
+		)
+		return
+	}
 
-	_, err = r.client.Update(ctx, plan.WorkspaceID.ValueString(), "destination", plan.ID.ValueString(), payload)
+	result, err := r.client.Update(ctx, plan.WorkspaceID.ValueString(), "destination", plan.ID.ValueString(), payload)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating destination", err.Error())
 		return
 	}
+	r.setAppliedAt(ctx, &plan, result)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, plan.WorkspaceID, plan.ID)...)
 }
 
 func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -475,6 +1397,15 @@ func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "destination", state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(deleteDependentLinks(ctx, r.client, state.WorkspaceID.ValueString(), state.ID.ValueString(),
+		state.CascadeDeleteLinks.ValueBool(), "destination")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if err := r.client.Delete(ctx, state.WorkspaceID.ValueString(), "destination", state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting destination", err.Error())
@@ -482,7 +1413,11 @@ func (r *destinationResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *destinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := splitImportID(req.ID, 2)
+	parts, diags := importObjectID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if parts == nil {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: workspace_id/destination_id")
 		return
@@ -498,14 +1433,115 @@ func (r *destinationResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	state := destinationModel{
-		WorkspaceID: types.StringValue(parts[0]),
-		ID:          types.StringValue(parts[1]),
-		ClickHouse:  types.ObjectNull(clickhouseAttrTypes),
-		BigQuery:    types.ObjectNull(bigqueryAttrTypes),
-	}
-	resp.Diagnostics.Append(r.readAPIIntoState(ctx, result, &state)...)
+	state := newDestinationState(parts[0], parts[1])
+	resp.Diagnostics.Append(r.readAPIIntoState(ctx, result, state)...)
 	// Password/credentials not available on import — API returns masked values
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
+}
+
+// quotedList formats values as a comma-separated list of quoted strings.
+func quotedList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// validatePort checks that a known port is within the TCP port range.
+func validatePort(p path.Path, port types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !port.IsNull() && !port.IsUnknown() && (port.ValueInt64() < 1 || port.ValueInt64() > 65535) {
+		diags.AddAttributeError(
+			p,
+			"Invalid port",
+			fmt.Sprintf("port must be between 1 and 65535, got %d.", port.ValueInt64()),
+		)
+	}
+	return diags
+}
+
+// setPayloadString sets payload[key] when v is known and not null.
+func setPayloadString(payload map[string]interface{}, key string, v types.String) {
+	if !v.IsNull() && !v.IsUnknown() {
+		payload[key] = v.ValueString()
+	}
+}
+
+// setPayloadInt64 sets payload[key] when v is known and not null.
+func setPayloadInt64(payload map[string]interface{}, key string, v types.Int64) {
+	if !v.IsNull() && !v.IsUnknown() {
+		payload[key] = v.ValueInt64()
+	}
+}
+
+// setPayloadBool sets payload[key] when v is known and not null.
+func setPayloadBool(payload map[string]interface{}, key string, v types.Bool) {
+	if !v.IsNull() && !v.IsUnknown() {
+		payload[key] = v.ValueBool()
+	}
+}
+
+// resultString returns result[key] as a types.String, or null when absent.
+func resultString(result map[string]interface{}, key string) types.String {
+	if v, ok := result[key].(string); ok {
+		return types.StringValue(v)
+	}
+	return types.StringNull()
+}
+
+// resultInt64 returns result[key] as a types.Int64, or null when absent.
+func resultInt64(result map[string]interface{}, key string) types.Int64 {
+	if v, ok := toInt64(result[key]); ok {
+		return types.Int64Value(v)
+	}
+	return types.Int64Null()
+}
+
+// resultBool returns result[key] as a types.Bool, or null when absent.
+func resultBool(result map[string]interface{}, key string) types.Bool {
+	if v, ok := result[key].(bool); ok {
+		return types.BoolValue(v)
+	}
+	return types.BoolNull()
+}
+
+// setPayloadStringMap sets payload[key] to the elements of v when v is known
+// and not null. Unknown elements are skipped.
+func setPayloadStringMap(payload map[string]interface{}, key string, v types.Map) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	m := make(map[string]interface{}, len(v.Elements()))
+	for k, e := range v.Elements() {
+		if s, ok := e.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			m[k] = s.ValueString()
+		}
+	}
+	payload[key] = m
+}
+
+// resultStringMap returns result[key] as a map of strings, or null when absent
+// or empty. Non-string values are formatted with fmt.Sprint. prev is the value
+// before the read: an empty prev is kept when Console has nothing, so an empty
+// map in config does not show as drift.
+func resultStringMap(result map[string]interface{}, key string, prev types.Map) types.Map {
+	raw, ok := result[key].(map[string]interface{})
+	if !ok || len(raw) == 0 {
+		if !prev.IsNull() && !prev.IsUnknown() && len(prev.Elements()) == 0 {
+			return prev
+		}
+		return types.MapNull(types.StringType)
+	}
+	elems := make(map[string]attr.Value, len(raw))
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			elems[k] = types.StringValue(s)
+		} else {
+			elems[k] = types.StringValue(fmt.Sprint(v))
+		}
+	}
+	return types.MapValueMust(types.StringType, elems)
 }
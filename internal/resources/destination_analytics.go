@@ -0,0 +1,341 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Product analytics destinations: Mixpanel, Amplitude, PostHog and GA4.
+
+var amplitudeDataResidencies = []string{"US", "EU"}
+
+type mixpanelModel struct {
+	ProjectID              types.String `tfsdk:"project_id"`
+	ProjectToken           types.String `tfsdk:"project_token"`
+	ServiceAccountUsername types.String `tfsdk:"service_account_username"`
+	ServiceAccountPassword types.String `tfsdk:"service_account_password"`
+}
+
+type amplitudeModel struct {
+	APIKey        types.String `tfsdk:"api_key"`
+	DataResidency types.String `tfsdk:"data_residency"`
+}
+
+type posthogModel struct {
+	Host       types.String `tfsdk:"host"`
+	ProjectKey types.String `tfsdk:"project_key"`
+}
+
+type ga4Model struct {
+	MeasurementID types.String `tfsdk:"measurement_id"`
+	APISecret     types.String `tfsdk:"api_secret"`
+}
+
+var mixpanelAttrTypes = map[string]attr.Type{
+	"project_id":               types.StringType,
+	"project_token":            types.StringType,
+	"service_account_username": types.StringType,
+	"service_account_password": types.StringType,
+}
+
+var amplitudeAttrTypes = map[string]attr.Type{
+	"api_key":        types.StringType,
+	"data_residency": types.StringType,
+}
+
+var posthogAttrTypes = map[string]attr.Type{
+	"host":        types.StringType,
+	"project_key": types.StringType,
+}
+
+var ga4AttrTypes = map[string]attr.Type{
+	"measurement_id": types.StringType,
+	"api_secret":     types.StringType,
+}
+
+func (m *destinationModel) mixpanel(ctx context.Context) (*mixpanelModel, diag.Diagnostics) {
+	if m.Mixpanel.IsNull() || m.Mixpanel.IsUnknown() {
+		return nil, nil
+	}
+	var mp mixpanelModel
+	diags := m.Mixpanel.As(ctx, &mp, basetypes.ObjectAsOptions{})
+	return &mp, diags
+}
+
+func (m *destinationModel) amplitude(ctx context.Context) (*amplitudeModel, diag.Diagnostics) {
+	if m.Amplitude.IsNull() || m.Amplitude.IsUnknown() {
+		return nil, nil
+	}
+	var amp amplitudeModel
+	diags := m.Amplitude.As(ctx, &amp, basetypes.ObjectAsOptions{})
+	return &amp, diags
+}
+
+func (m *destinationModel) posthog(ctx context.Context) (*posthogModel, diag.Diagnostics) {
+	if m.PostHog.IsNull() || m.PostHog.IsUnknown() {
+		return nil, nil
+	}
+	var ph posthogModel
+	diags := m.PostHog.As(ctx, &ph, basetypes.ObjectAsOptions{})
+	return &ph, diags
+}
+
+func (m *destinationModel) ga4(ctx context.Context) (*ga4Model, diag.Diagnostics) {
+	if m.GA4.IsNull() || m.GA4.IsUnknown() {
+		return nil, nil
+	}
+	var ga ga4Model
+	diags := m.GA4.As(ctx, &ga, basetypes.ObjectAsOptions{})
+	return &ga, diags
+}
+
+func mixpanelSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Mixpanel destination configuration.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Mixpanel project ID.",
+			},
+			"project_token": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Mixpanel project token. API returns masked value; stored in state from user config.",
+			},
+			"service_account_username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Service account username, required to import historical events and merge identities.",
+			},
+			"service_account_password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Service account secret. API returns masked value; stored in state from user config.",
+			},
+		},
+	}
+}
+
+func amplitudeSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Amplitude destination configuration.",
+		Attributes: map[string]schema.Attribute{
+			"api_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Amplitude project API key. API returns masked value; stored in state from user config.",
+			},
+			"data_residency": schema.StringAttribute{
+				Optional:    true,
+				Description: "Data center the project is hosted in: US or EU. Console defaults to US.",
+			},
+		},
+	}
+}
+
+func posthogSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "PostHog destination configuration.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Optional:    true,
+				Description: "PostHog instance URL. Console defaults to PostHog Cloud.",
+			},
+			"project_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "PostHog project API key. API returns masked value; stored in state from user config.",
+			},
+		},
+	}
+}
+
+func ga4SchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Google Analytics 4 destination configuration.",
+		Attributes: map[string]schema.Attribute{
+			"measurement_id": schema.StringAttribute{
+				Required:    true,
+				Description: "GA4 measurement ID (G-XXXXXXX).",
+			},
+			"api_secret": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Measurement Protocol API secret. API returns masked value; stored in state from user config.",
+			},
+		},
+	}
+}
+
+func validateMixpanel(mp *mixpanelModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !mp.ServiceAccountUsername.IsUnknown() && !mp.ServiceAccountPassword.IsUnknown() &&
+		mp.ServiceAccountUsername.IsNull() != mp.ServiceAccountPassword.IsNull() {
+		diags.AddAttributeError(
+			path.Root("mixpanel").AtName("service_account_password"),
+			"Incomplete service account",
+			"service_account_username and service_account_password must be set together.",
+		)
+	}
+	return diags
+}
+
+func validateAmplitude(amp *amplitudeModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !amp.DataResidency.IsNull() && !amp.DataResidency.IsUnknown() && !slices.Contains(amplitudeDataResidencies, amp.DataResidency.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("amplitude").AtName("data_residency"),
+			"Invalid data residency",
+			fmt.Sprintf("data_residency must be one of %s, got %q.", quotedList(amplitudeDataResidencies), amp.DataResidency.ValueString()),
+		)
+	}
+	return diags
+}
+
+func validatePosthog(ph *posthogModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !ph.Host.IsNull() && !ph.Host.IsUnknown() {
+		host := ph.Host.ValueString()
+		if !strings.HasPrefix(host, "https://") && !strings.HasPrefix(host, "http://") {
+			diags.AddAttributeError(
+				path.Root("posthog").AtName("host"),
+				"Invalid PostHog host",
+				fmt.Sprintf("host must be an http:// or https:// URL, got %q.", host),
+			)
+		}
+	}
+	return diags
+}
+
+func validateGa4(ga *ga4Model) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !ga.MeasurementID.IsNull() && !ga.MeasurementID.IsUnknown() && !strings.HasPrefix(ga.MeasurementID.ValueString(), "G-") {
+		diags.AddAttributeError(
+			path.Root("ga4").AtName("measurement_id"),
+			"Invalid measurement ID",
+			fmt.Sprintf("measurement_id must start with G-, got %q.", ga.MeasurementID.ValueString()),
+		)
+	}
+	return diags
+}
+
+func mixpanelToPayload(mp *mixpanelModel, payload map[string]interface{}) {
+	setPayloadString(payload, "projectId", mp.ProjectID)
+	setPayloadString(payload, "projectToken", mp.ProjectToken)
+	setPayloadString(payload, "serviceAccountUserName", mp.ServiceAccountUsername)
+	setPayloadString(payload, "serviceAccountPassword", mp.ServiceAccountPassword)
+}
+
+func amplitudeToPayload(amp *amplitudeModel, payload map[string]interface{}) {
+	setPayloadString(payload, "key", amp.APIKey)
+	setPayloadString(payload, "dataResidency", amp.DataResidency)
+}
+
+func posthogToPayload(ph *posthogModel, payload map[string]interface{}) {
+	setPayloadString(payload, "host", ph.Host)
+	setPayloadString(payload, "key", ph.ProjectKey)
+}
+
+func ga4ToPayload(ga *ga4Model, payload map[string]interface{}) {
+	setPayloadString(payload, "measurementId", ga.MeasurementID)
+	setPayloadString(payload, "apiSecret", ga.APISecret)
+}
+
+// readMixpanelBlock maps a Mixpanel destination from the API into the mixpanel
+// block. prev holds the state before the read.
+func readMixpanelBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	mp := &mixpanelModel{
+		ProjectID:              resultString(result, "projectId"),
+		ProjectToken:           types.StringNull(),
+		ServiceAccountUsername: resultString(result, "serviceAccountUserName"),
+		ServiceAccountPassword: types.StringNull(),
+	}
+	// Token and service account secret: API returns masked values — preserve state values.
+	oldMP, d := prev.mixpanel(ctx)
+	diags.Append(d...)
+	if oldMP != nil {
+		mp.ProjectToken = oldMP.ProjectToken
+		mp.ServiceAccountPassword = oldMP.ServiceAccountPassword
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, mixpanelAttrTypes, mp)
+	diags.Append(d...)
+	return objVal, diags
+}
+
+// readAmplitudeBlock maps an Amplitude destination from the API into the
+// amplitude block. prev holds the state before the read.
+func readAmplitudeBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	amp := &amplitudeModel{
+		APIKey:        types.StringNull(),
+		DataResidency: resultString(result, "dataResidency"),
+	}
+	// API key: API returns masked value — preserve state value.
+	oldAmp, d := prev.amplitude(ctx)
+	diags.Append(d...)
+	if oldAmp != nil {
+		amp.APIKey = oldAmp.APIKey
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, amplitudeAttrTypes, amp)
+	diags.Append(d...)
+	return objVal, diags
+}
+
+// readPosthogBlock maps a PostHog destination from the API into the posthog
+// block. prev holds the state before the read.
+func readPosthogBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ph := &posthogModel{
+		Host:       resultString(result, "host"),
+		ProjectKey: types.StringNull(),
+	}
+	// Project key: API returns masked value — preserve state value.
+	oldPH, d := prev.posthog(ctx)
+	diags.Append(d...)
+	if oldPH != nil {
+		ph.ProjectKey = oldPH.ProjectKey
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, posthogAttrTypes, ph)
+	diags.Append(d...)
+	return objVal, diags
+}
+
+// readGa4Block maps a GA4 destination from the API into the ga4 block. prev
+// holds the state before the read.
+func readGa4Block(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ga := &ga4Model{
+		MeasurementID: resultString(result, "measurementId"),
+		APISecret:     types.StringNull(),
+	}
+	// API secret: API returns masked value — preserve state value.
+	oldGA, d := prev.ga4(ctx)
+	diags.Append(d...)
+	if oldGA != nil {
+		ga.APISecret = oldGA.APISecret
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, ga4AttrTypes, ga)
+	diags.Append(d...)
+	return objVal, diags
+}
@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// bigqueryTableAffixPattern matches the characters BigQuery allows in table
+// names, so a prefix or suffix never produces an invalid table.
+var bigqueryTableAffixPattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// validateBigqueryCredentials checks that a service account key parses and
+// belongs to projectID. Console only reports a broken key when it first
+// connects, so catching it here saves a failed apply.
+func validateBigqueryCredentials(p path.Path, credentials, projectID types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if credentials.IsNull() || credentials.IsUnknown() {
+		return diags
+	}
+
+	key, err := decodeJSONObject(credentials.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid service account key",
+			fmt.Sprintf("%s %s.", p.String(), err.Error()),
+		)
+		return diags
+	}
+	if keyType, _ := key["type"].(string); keyType != "service_account" {
+		diags.AddAttributeError(
+			p,
+			"Invalid service account key",
+			fmt.Sprintf(`%s must be a service account key with "type": "service_account", got type %q.`, p.String(), keyType),
+		)
+	}
+	keyProject, ok := key["project_id"].(string)
+	if ok && !projectID.IsNull() && !projectID.IsUnknown() && keyProject != projectID.ValueString() {
+		diags.AddAttributeError(
+			p,
+			"Service account project mismatch",
+			fmt.Sprintf("%s belongs to project %q, but project_id is %q.", p.String(), keyProject, projectID.ValueString()),
+		)
+	}
+	return diags
+}
+
+// validateBigqueryOptions checks the dataset and table options of a bigquery block.
+func validateBigqueryOptions(bq *bigqueryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	block := path.Root("bigquery")
+
+	diags.Append(validateBigqueryCredentials(block.AtName("credentials"), bq.Credentials, bq.ProjectID)...)
+	diags.Append(validateBigqueryCredentials(block.AtName("credentials_wo"), bq.CredentialsWO, bq.ProjectID)...)
+
+	if !bq.PartitionExpirationDays.IsNull() && !bq.PartitionExpirationDays.IsUnknown() && bq.PartitionExpirationDays.ValueInt64() < 1 {
+		diags.AddAttributeError(
+			block.AtName("partition_expiration_days"),
+			"Invalid partition expiration",
+			fmt.Sprintf("partition_expiration_days must be at least 1, got %d.", bq.PartitionExpirationDays.ValueInt64()),
+		)
+	}
+	for name, v := range map[string]types.String{"table_prefix": bq.TablePrefix, "table_suffix": bq.TableSuffix} {
+		if !v.IsNull() && !v.IsUnknown() && !bigqueryTableAffixPattern.MatchString(v.ValueString()) {
+			diags.AddAttributeError(
+				block.AtName(name),
+				"Invalid table name affix",
+				fmt.Sprintf("%s may only contain letters, digits and underscores, got %q.", name, v.ValueString()),
+			)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,149 @@
+package resources
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// clickhouseEngineModel overrides the table engine Bulker uses when it
+// creates ClickHouse tables.
+type clickhouseEngineModel struct {
+	RawStatement types.String `tfsdk:"raw_statement"`
+	PartitionBy  types.String `tfsdk:"partition_by"`
+	OrderBy      types.String `tfsdk:"order_by"`
+	PrimaryKey   types.String `tfsdk:"primary_key"`
+}
+
+var clickhouseEngineAttrTypes = map[string]attr.Type{
+	"raw_statement": types.StringType,
+	"partition_by":  types.StringType,
+	"order_by":      types.StringType,
+	"primary_key":   types.StringType,
+}
+
+// engine returns the engine overrides, or nil when unset.
+func (m *clickhouseModel) engine(ctx context.Context) (*clickhouseEngineModel, diag.Diagnostics) {
+	if m.Engine.IsNull() || m.Engine.IsUnknown() {
+		return nil, nil
+	}
+	var e clickhouseEngineModel
+	diags := m.Engine.As(ctx, &e, basetypes.ObjectAsOptions{})
+	return &e, diags
+}
+
+func clickhouseEngineSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Table engine overrides. Bulker uses ReplacingMergeTree (Replicated* with cluster) when unset.",
+		Attributes: map[string]schema.Attribute{
+			"raw_statement": schema.StringAttribute{
+				Optional:    true,
+				Description: "Full ENGINE clause (e.g., ReplacingMergeTree(_timestamp)). Conflicts with the other engine attributes.",
+			},
+			"partition_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "PARTITION BY expression.",
+			},
+			"order_by": schema.StringAttribute{
+				Optional:    true,
+				Description: "ORDER BY expression.",
+			},
+			"primary_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "PRIMARY KEY expression. Must be a prefix of order_by.",
+			},
+		},
+	}
+}
+
+// validateClickhouseOptions checks the optional connection and engine
+// settings of a clickhouse block.
+func validateClickhouseOptions(ctx context.Context, ch *clickhouseModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	block := path.Root("clickhouse")
+
+	if !ch.CACertificate.IsNull() && !ch.CACertificate.IsUnknown() {
+		if p, _ := pem.Decode([]byte(ch.CACertificate.ValueString())); p == nil || p.Type != "CERTIFICATE" {
+			diags.AddAttributeError(
+				block.AtName("ca_certificate"),
+				"Invalid CA certificate",
+				"ca_certificate must be a PEM-encoded certificate.",
+			)
+		}
+	}
+
+	engine, d := ch.engine(ctx)
+	diags.Append(d...)
+	if engine != nil && !engine.RawStatement.IsNull() && !engine.RawStatement.IsUnknown() {
+		for name, v := range map[string]types.String{
+			"partition_by": engine.PartitionBy,
+			"order_by":     engine.OrderBy,
+			"primary_key":  engine.PrimaryKey,
+		} {
+			if !v.IsNull() {
+				diags.AddAttributeError(
+					block.AtName("engine").AtName(name),
+					"Conflicting engine attributes",
+					fmt.Sprintf("%s cannot be combined with raw_statement.", name),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+// clickhouseEngineToPayload sets payload["engine"] from the engine overrides.
+func clickhouseEngineToPayload(ctx context.Context, ch *clickhouseModel, payload map[string]interface{}) error {
+	engine, diags := ch.engine(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("reading engine: %v", diags.Errors())
+	}
+	if engine == nil {
+		return nil
+	}
+	e := map[string]interface{}{}
+	setPayloadString(e, "rawStatement", engine.RawStatement)
+	setPayloadString(e, "partitionBy", engine.PartitionBy)
+	setPayloadString(e, "orderBy", engine.OrderBy)
+	setPayloadString(e, "primaryKey", engine.PrimaryKey)
+	payload["engine"] = e
+	return nil
+}
+
+// readClickhouseEngine maps result["engine"] into the engine attribute, or
+// null when Console has no overrides. prev is the engine value before the
+// read: an empty engine block is kept when Console has no overrides, so it
+// does not show as drift.
+func readClickhouseEngine(ctx context.Context, result map[string]interface{}, prev types.Object) (types.Object, diag.Diagnostics) {
+	raw, ok := result["engine"].(map[string]interface{})
+	if !ok || len(raw) == 0 {
+		if !prev.IsNull() && !prev.IsUnknown() && allNull(prev.Attributes()) {
+			return prev, nil
+		}
+		return types.ObjectNull(clickhouseEngineAttrTypes), nil
+	}
+	return types.ObjectValueFrom(ctx, clickhouseEngineAttrTypes, &clickhouseEngineModel{
+		RawStatement: resultString(raw, "rawStatement"),
+		PartitionBy:  resultString(raw, "partitionBy"),
+		OrderBy:      resultString(raw, "orderBy"),
+		PrimaryKey:   resultString(raw, "primaryKey"),
+	})
+}
+
+// allNull reports whether every attribute value is null.
+func allNull(attrs map[string]attr.Value) bool {
+	for _, v := range attrs {
+		if !v.IsNull() {
+			return false
+		}
+	}
+	return true
+}
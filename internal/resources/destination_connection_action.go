@@ -0,0 +1,141 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &destinationTestAction{}
+	_ action.ActionWithConfigure = &destinationTestAction{}
+)
+
+type destinationTestAction struct {
+	client *client.Client
+}
+
+type destinationTestModel struct {
+	WorkspaceID   types.String `tfsdk:"workspace_id"`
+	DestinationID types.String `tfsdk:"destination_id"`
+	Secrets       types.Map    `tfsdk:"secrets"`
+}
+
+func NewDestinationTestAction() action.Action {
+	return &destinationTestAction{}
+}
+
+func (a *destinationTestAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_destination_test"
+}
+
+func (a *destinationTestAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Tests the connection of a destination as stored in Console, failing with Console's error " +
+			"if it cannot connect. Console returns secrets masked, so pass them in secrets to test with them. " +
+			"Requires Terraform 1.14+.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Jitsu workspace ID.",
+			},
+			"destination_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the destination to test.",
+			},
+			"secrets": schema.MapAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				ElementType: types.StringType,
+				Description: "Secret settings to test with, keyed by their Console name (for example password or keyFile). " +
+					"They replace the masked values Console returns for the stored destination and may be ephemeral. " +
+					"Secrets left out are sent masked, and a warning names them.",
+			},
+		},
+	}
+}
+
+func (a *destinationTestAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.client = configureActionClient(req, resp)
+}
+
+func (a *destinationTestAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config destinationTestModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	wsID, destID := config.WorkspaceID.ValueString(), config.DestinationID.ValueString()
+
+	result, err := a.client.Read(ctx, wsID, "destination", destID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading destination", err.Error())
+		return
+	}
+	if result == nil {
+		resp.Diagnostics.AddError("Destination not found", fmt.Sprintf("Destination %s not found in workspace %s", destID, wsID))
+		return
+	}
+
+	// Console returns secrets masked, so the configured secrets replace the
+	// placeholders; the check runs either way and reports Console's result.
+	secrets := map[string]string{}
+	resp.Diagnostics.Append(config.Secrets.ElementsAs(ctx, &secrets, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for k, v := range secrets {
+		result[k] = v
+	}
+	if masked := maskedFields("", result); len(masked) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Destination tested with masked secrets",
+			fmt.Sprintf("Destination %s has secret fields that Console only returns masked: %s. They are sent to the check "+
+				"as stored, so a failed check may come from them. Set them in secrets to test with their values.",
+				destID, strings.Join(masked, ", ")),
+		)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Testing connection of destination %s", destID),
+	})
+	if err := a.client.TestDestination(ctx, wsID, result); err != nil {
+		resp.Diagnostics.AddError("Destination connection test failed", err.Error())
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Destination %s connected successfully", destID),
+	})
+}
+
+// maskedFields returns the paths of the values in v, below prefix, that Console
+// replaced with its mask placeholder.
+func maskedFields(prefix string, v interface{}) []string {
+	var fields []string
+	switch v := v.(type) {
+	case string:
+		if v == client.MaskedValue {
+			fields = append(fields, prefix)
+		}
+	case map[string]interface{}:
+		for k, item := range v {
+			name := k
+			if prefix != "" {
+				name = prefix + "." + k
+			}
+			fields = append(fields, maskedFields(name, item)...)
+		}
+	case []interface{}:
+		for i, item := range v {
+			fields = append(fields, maskedFields(fmt.Sprintf("%s[%d]", prefix, i), item)...)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func invokeDestinationTest(t *testing.T, checkResponse string) (action.InvokeResponse, map[string]interface{}) {
+	t.Helper()
+	return invokeDestinationTestWith(t, `{"id": "dest", "destinationType": "clickhouse", "hosts": ["bad-host:8123"]}`, nil, checkResponse)
+}
+
+func invokeDestinationTestWith(t *testing.T, stored string, secrets map[string]string, checkResponse string) (action.InvokeResponse, map[string]interface{}) {
+	t.Helper()
+	ctx := context.Background()
+
+	var checked map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/ws/config/destination/dest":
+			_, _ = w.Write([]byte(stored))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/ws/destinations/check":
+			if err := json.NewDecoder(r.Body).Decode(&checked); err != nil {
+				t.Errorf("decoding check body: %v", err)
+			}
+			_, _ = w.Write([]byte(checkResponse))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	a := &destinationTestAction{client: client.New(server.URL, "token", "", "test")}
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	secretsType := tftypes.Map{ElementType: tftypes.String}
+	secretsValue := tftypes.NewValue(secretsType, nil)
+	if secrets != nil {
+		values := map[string]tftypes.Value{}
+		for k, v := range secrets {
+			values[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		secretsValue = tftypes.NewValue(secretsType, values)
+	}
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"workspace_id":   tftypes.NewValue(tftypes.String, "ws"),
+			"destination_id": tftypes.NewValue(tftypes.String, "dest"),
+			"secrets":        secretsValue,
+		}),
+	}
+
+	resp := action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+	a.Invoke(ctx, action.InvokeRequest{Config: config}, &resp)
+	return resp, checked
+}
+
+func TestDestinationTestAction_Success(t *testing.T) {
+	resp, checked := invokeDestinationTest(t, `{"ok": true}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if checked["destinationType"] != "clickhouse" {
+		t.Fatalf("check should receive the stored destination config, got %v", checked)
+	}
+}
+
+func TestDestinationTestAction_ReportsConsoleError(t *testing.T) {
+	resp, _ := invokeDestinationTest(t, `{"ok": false, "error": "dial tcp: lookup bad-host: no such host"}`)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected connection test failure")
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "no such host") {
+		t.Fatalf("error should carry Console's message, got %q", detail)
+	}
+}
+
+func TestDestinationTestAction_MaskedSecrets(t *testing.T) {
+	resp, checked := invokeDestinationTestWith(t,
+		`{"id": "dest", "destinationType": "clickhouse", "hosts": ["ch:8123"], "password": "__MASKED_BY_JITSU__"}`,
+		nil, `{"ok": true}`)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if checked["password"] != client.MaskedValue {
+		t.Fatalf("check should run with the stored config, got %v", checked)
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "password") {
+		t.Fatalf("expected a warning naming the masked field, got %v", resp.Diagnostics)
+	}
+}
+
+func TestDestinationTestAction_Secrets(t *testing.T) {
+	resp, checked := invokeDestinationTestWith(t,
+		`{"id": "dest", "destinationType": "clickhouse", "hosts": ["ch:8123"], "password": "__MASKED_BY_JITSU__"}`,
+		map[string]string{"password": "s3cret"}, `{"ok": true}`)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if checked["password"] != "s3cret" || checked["destinationType"] != "clickhouse" {
+		t.Fatalf("check should receive the stored config with the secrets merged in, got %v", checked)
+	}
+}
+
+func TestMaskedFields(t *testing.T) {
+	result := map[string]interface{}{
+		"password": client.MaskedValue,
+		"hosts":    []interface{}{"ch:8123"},
+		"headers": []interface{}{
+			map[string]interface{}{"name": "Authorization", "value": client.MaskedValue},
+		},
+	}
+	got := maskedFields("", result)
+	want := []string{"headers[0].value", "password"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("maskedFields = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,160 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// mysqlTLSModes are the tls values of the MySQL driver Console uses.
+var mysqlTLSModes = []string{"false", "true", "skip-verify", "preferred"}
+
+type mysqlModel struct {
+	Host            types.String `tfsdk:"host"`
+	Port            types.Int64  `tfsdk:"port"`
+	Database        types.String `tfsdk:"database"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordFromEnv types.String `tfsdk:"password_from_env"`
+	PasswordFile    types.String `tfsdk:"password_file"`
+	PasswordHash    types.String `tfsdk:"password_hash"`
+	TLSMode         types.String `tfsdk:"tls_mode"`
+	Parameters      types.Map    `tfsdk:"parameters"`
+	SSHTunnel       types.Object `tfsdk:"ssh_tunnel"`
+}
+
+var mysqlAttrTypes = withSecretRefTypes(map[string]attr.Type{
+	"host":       types.StringType,
+	"port":       types.Int64Type,
+	"database":   types.StringType,
+	"username":   types.StringType,
+	"password":   types.StringType,
+	"tls_mode":   types.StringType,
+	"parameters": types.MapType{ElemType: types.StringType},
+	"ssh_tunnel": types.ObjectType{AttrTypes: sshTunnelAttrTypes},
+}, "password")
+
+func (m *destinationModel) mysql(ctx context.Context) (*mysqlModel, diag.Diagnostics) {
+	if m.MySQL.IsNull() || m.MySQL.IsUnknown() {
+		return nil, nil
+	}
+	var my mysqlModel
+	diags := m.MySQL.As(ctx, &my, basetypes.ObjectAsOptions{})
+	return &my, diags
+}
+
+func mysqlSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "MySQL destination configuration.",
+		Attributes: withSecretRef(map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Database host.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Database port. Console defaults to 3306.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Database name.",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "Database username.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Database password. API returns masked value; stored in state from user config. Conflicts with password_from_env and password_file.",
+			},
+			"tls_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "TLS mode: false, true, skip-verify or preferred.",
+			},
+			"parameters": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Extra connection parameters appended to the DSN (e.g., parseTime, timeout).",
+			},
+			"ssh_tunnel": sshTunnelSchemaAttribute(),
+		}, "password", "database password"),
+	}
+}
+
+func validateMysql(my *mysqlModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	block := path.Root("mysql")
+
+	if !my.TLSMode.IsNull() && !my.TLSMode.IsUnknown() && !slices.Contains(mysqlTLSModes, my.TLSMode.ValueString()) {
+		diags.AddAttributeError(
+			block.AtName("tls_mode"),
+			"Invalid TLS mode",
+			fmt.Sprintf("tls_mode must be one of %s, got %q.", quotedList(mysqlTLSModes), my.TLSMode.ValueString()),
+		)
+	}
+	diags.Append(validatePort(block.AtName("port"), my.Port)...)
+	if !my.Parameters.IsNull() && !my.Parameters.IsUnknown() {
+		if _, ok := my.Parameters.Elements()["tls"]; ok {
+			diags.AddAttributeError(
+				block.AtName("parameters").AtMapKey("tls"),
+				"Conflicting TLS configuration",
+				"Set TLS with tls_mode instead of parameters.",
+			)
+		}
+	}
+	return diags
+}
+
+func mysqlToPayload(my *mysqlModel, payload map[string]interface{}) {
+	setPayloadString(payload, "host", my.Host)
+	setPayloadInt64(payload, "port", my.Port)
+	setPayloadString(payload, "database", my.Database)
+	setPayloadString(payload, "username", my.Username)
+	setPayloadString(payload, "password", my.Password)
+	setPayloadString(payload, "tls", my.TLSMode)
+	setPayloadStringMap(payload, "parameters", my.Parameters)
+}
+
+// readMysqlBlock maps a MySQL destination from the API into the mysql block.
+// prev holds the state before the read.
+func readMysqlBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	my := &mysqlModel{
+		Host:     resultString(result, "host"),
+		Port:     resultInt64(result, "port"),
+		Database: resultString(result, "database"),
+		Username: resultString(result, "username"),
+		Password: types.StringNull(),
+		TLSMode:  resultString(result, "tls"),
+	}
+	// Password: API returns masked value — preserve state value.
+	oldMy, d := prev.mysql(ctx)
+	diags.Append(d...)
+	prevTunnel := types.ObjectNull(sshTunnelAttrTypes)
+	prevParameters := types.MapNull(types.StringType)
+	if oldMy != nil {
+		prevParameters = oldMy.Parameters
+		my.Password = oldMy.Password
+		my.PasswordFromEnv = oldMy.PasswordFromEnv
+		my.PasswordFile = oldMy.PasswordFile
+		my.PasswordHash = oldMy.PasswordHash
+		prevTunnel = oldMy.SSHTunnel
+	}
+	my.Parameters = resultStringMap(result, "parameters", prevParameters)
+	my.SSHTunnel, d = readSSHTunnel(ctx, result, prevTunnel)
+	diags.Append(d...)
+
+	objVal, d := types.ObjectValueFrom(ctx, mysqlAttrTypes, my)
+	diags.Append(d...)
+	return objVal, diags
+}
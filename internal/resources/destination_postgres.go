@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// postgresSSLModes are the SSL modes Console accepts for Postgres destinations.
+var postgresSSLModes = []string{"disable", "require", "verify-ca", "verify-full"}
+
+type postgresModel struct {
+	Host            types.String `tfsdk:"host"`
+	Port            types.Int64  `tfsdk:"port"`
+	Database        types.String `tfsdk:"database"`
+	DefaultSchema   types.String `tfsdk:"default_schema"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordFromEnv types.String `tfsdk:"password_from_env"`
+	PasswordFile    types.String `tfsdk:"password_file"`
+	PasswordHash    types.String `tfsdk:"password_hash"`
+	SSLMode         types.String `tfsdk:"ssl_mode"`
+	SSLServerCA     types.String `tfsdk:"ssl_server_ca"`
+	SSLClientCert   types.String `tfsdk:"ssl_client_cert"`
+	SSLClientKey    types.String `tfsdk:"ssl_client_key"`
+	SSHTunnel       types.Object `tfsdk:"ssh_tunnel"`
+}
+
+var postgresAttrTypes = withSecretRefTypes(map[string]attr.Type{
+	"host":            types.StringType,
+	"port":            types.Int64Type,
+	"database":        types.StringType,
+	"default_schema":  types.StringType,
+	"username":        types.StringType,
+	"password":        types.StringType,
+	"ssl_mode":        types.StringType,
+	"ssl_server_ca":   types.StringType,
+	"ssl_client_cert": types.StringType,
+	"ssl_client_key":  types.StringType,
+	"ssh_tunnel":      types.ObjectType{AttrTypes: sshTunnelAttrTypes},
+}, "password")
+
+func (m *destinationModel) postgres(ctx context.Context) (*postgresModel, diag.Diagnostics) {
+	if m.Postgres.IsNull() || m.Postgres.IsUnknown() {
+		return nil, nil
+	}
+	var pg postgresModel
+	diags := m.Postgres.As(ctx, &pg, basetypes.ObjectAsOptions{})
+	return &pg, diags
+}
+
+func postgresSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Postgres destination configuration.",
+		Attributes: withSecretRef(map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Database host.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Database port. Console defaults to 5432.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Database name.",
+			},
+			"default_schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema tables are created in. Console defaults to public.",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "Database username.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Database password. API returns masked value; stored in state from user config. Conflicts with password_from_env and password_file.",
+			},
+			"ssl_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "SSL mode: disable, require, verify-ca or verify-full.",
+			},
+			"ssl_server_ca": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded CA certificate used to verify the server (verify-ca and verify-full).",
+			},
+			"ssl_client_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded client certificate. Must be set together with ssl_client_key.",
+			},
+			"ssl_client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded client private key. API returns masked value; stored in state from user config.",
+			},
+			"ssh_tunnel": sshTunnelSchemaAttribute(),
+		}, "password", "database password"),
+	}
+}
+
+func validatePostgres(pg *postgresModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	block := path.Root("postgres")
+
+	if !pg.SSLMode.IsNull() && !pg.SSLMode.IsUnknown() && !slices.Contains(postgresSSLModes, pg.SSLMode.ValueString()) {
+		diags.AddAttributeError(
+			block.AtName("ssl_mode"),
+			"Invalid SSL mode",
+			fmt.Sprintf("ssl_mode must be one of %s, got %q.", quotedList(postgresSSLModes), pg.SSLMode.ValueString()),
+		)
+	}
+	diags.Append(validatePort(block.AtName("port"), pg.Port)...)
+	if !pg.SSLClientCert.IsUnknown() && !pg.SSLClientKey.IsUnknown() && pg.SSLClientCert.IsNull() != pg.SSLClientKey.IsNull() {
+		diags.AddAttributeError(
+			block.AtName("ssl_client_key"),
+			"Incomplete client certificate",
+			"ssl_client_cert and ssl_client_key must be set together.",
+		)
+	}
+	return diags
+}
+
+func postgresToPayload(pg *postgresModel, payload map[string]interface{}) {
+	setPayloadString(payload, "host", pg.Host)
+	setPayloadInt64(payload, "port", pg.Port)
+	setPayloadString(payload, "database", pg.Database)
+	setPayloadString(payload, "defaultSchema", pg.DefaultSchema)
+	setPayloadString(payload, "username", pg.Username)
+	setPayloadString(payload, "password", pg.Password)
+	setPayloadString(payload, "sslMode", pg.SSLMode)
+	setPayloadString(payload, "sslServerCA", pg.SSLServerCA)
+	setPayloadString(payload, "sslClientCert", pg.SSLClientCert)
+	setPayloadString(payload, "sslClientKey", pg.SSLClientKey)
+}
+
+// readPostgresBlock maps a Postgres destination from the API into the postgres
+// block. prev holds the state before the read.
+func readPostgresBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	pg := &postgresModel{
+		Host:          resultString(result, "host"),
+		Port:          resultInt64(result, "port"),
+		Database:      resultString(result, "database"),
+		DefaultSchema: resultString(result, "defaultSchema"),
+		Username:      resultString(result, "username"),
+		Password:      types.StringNull(),
+		SSLMode:       resultString(result, "sslMode"),
+		SSLServerCA:   resultString(result, "sslServerCA"),
+		SSLClientCert: resultString(result, "sslClientCert"),
+		SSLClientKey:  types.StringNull(),
+	}
+	// Password and client key: API returns masked values — preserve state values.
+	oldPG, d := prev.postgres(ctx)
+	diags.Append(d...)
+	prevTunnel := types.ObjectNull(sshTunnelAttrTypes)
+	if oldPG != nil {
+		pg.Password = oldPG.Password
+		pg.PasswordFromEnv = oldPG.PasswordFromEnv
+		pg.PasswordFile = oldPG.PasswordFile
+		pg.PasswordHash = oldPG.PasswordHash
+		pg.SSLClientKey = oldPG.SSLClientKey
+		prevTunnel = oldPG.SSHTunnel
+	}
+	pg.SSHTunnel, d = readSSHTunnel(ctx, result, prevTunnel)
+	diags.Append(d...)
+
+	objVal, d := types.ObjectValueFrom(ctx, postgresAttrTypes, pg)
+	diags.Append(d...)
+	return objVal, diags
+}
@@ -0,0 +1,222 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// redshiftServerSideEncryptions are the S3 server-side encryption options for
+// Redshift staging buckets.
+var redshiftServerSideEncryptions = []string{"AES256", "aws:kms"}
+
+type redshiftModel struct {
+	Host                 types.String `tfsdk:"host"`
+	Port                 types.Int64  `tfsdk:"port"`
+	Database             types.String `tfsdk:"database"`
+	DefaultSchema        types.String `tfsdk:"default_schema"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	PasswordFromEnv      types.String `tfsdk:"password_from_env"`
+	PasswordFile         types.String `tfsdk:"password_file"`
+	PasswordHash         types.String `tfsdk:"password_hash"`
+	S3Bucket             types.String `tfsdk:"s3_bucket"`
+	S3Region             types.String `tfsdk:"s3_region"`
+	S3AccessKeyID        types.String `tfsdk:"s3_access_key_id"`
+	S3SecretAccessKey    types.String `tfsdk:"s3_secret_access_key"`
+	ServerSideEncryption types.String `tfsdk:"server_side_encryption"`
+	SSHTunnel            types.Object `tfsdk:"ssh_tunnel"`
+}
+
+var redshiftAttrTypes = withSecretRefTypes(map[string]attr.Type{
+	"host":                   types.StringType,
+	"port":                   types.Int64Type,
+	"database":               types.StringType,
+	"default_schema":         types.StringType,
+	"username":               types.StringType,
+	"password":               types.StringType,
+	"s3_bucket":              types.StringType,
+	"s3_region":              types.StringType,
+	"s3_access_key_id":       types.StringType,
+	"s3_secret_access_key":   types.StringType,
+	"server_side_encryption": types.StringType,
+	"ssh_tunnel":             types.ObjectType{AttrTypes: sshTunnelAttrTypes},
+}, "password")
+
+func (m *destinationModel) redshift(ctx context.Context) (*redshiftModel, diag.Diagnostics) {
+	if m.Redshift.IsNull() || m.Redshift.IsUnknown() {
+		return nil, nil
+	}
+	var rs redshiftModel
+	diags := m.Redshift.As(ctx, &rs, basetypes.ObjectAsOptions{})
+	return &rs, diags
+}
+
+func redshiftSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Redshift destination configuration.",
+		Attributes: withSecretRef(map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Cluster endpoint host.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Cluster port. Console defaults to 5439.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Database name.",
+			},
+			"default_schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema tables are created in. Console defaults to public.",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "Database username.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Database password. API returns masked value; stored in state from user config. Conflicts with password_from_env and password_file.",
+			},
+			"s3_bucket": schema.StringAttribute{
+				Optional:    true,
+				Description: "S3 bucket used to stage bulk loads. The s3_* attributes must be set together.",
+			},
+			"s3_region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Region of the S3 staging bucket.",
+			},
+			"s3_access_key_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "AWS access key ID with write access to the staging bucket.",
+			},
+			"s3_secret_access_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS secret access key. API returns masked value; stored in state from user config.",
+			},
+			"server_side_encryption": schema.StringAttribute{
+				Optional:    true,
+				Description: "Server-side encryption for staged files: AES256 or aws:kms. Requires S3 staging.",
+			},
+			"ssh_tunnel": sshTunnelSchemaAttribute(),
+		}, "password", "database password"),
+	}
+}
+
+func validateRedshift(rs *redshiftModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	block := path.Root("redshift")
+
+	diags.Append(validatePort(block.AtName("port"), rs.Port)...)
+
+	staging := map[string]types.String{
+		"s3_bucket":            rs.S3Bucket,
+		"s3_region":            rs.S3Region,
+		"s3_access_key_id":     rs.S3AccessKeyID,
+		"s3_secret_access_key": rs.S3SecretAccessKey,
+	}
+	var set, missing []string
+	unknown := false
+	for name, v := range staging {
+		switch {
+		case v.IsUnknown():
+			unknown = true
+		case v.IsNull():
+			missing = append(missing, name)
+		default:
+			set = append(set, name)
+		}
+	}
+	slices.Sort(missing)
+	if !unknown && len(set) > 0 && len(missing) > 0 {
+		diags.AddAttributeError(
+			block.AtName(missing[0]),
+			"Incomplete S3 staging configuration",
+			fmt.Sprintf("s3_bucket, s3_region, s3_access_key_id and s3_secret_access_key must be set together; missing %s.",
+				quotedList(missing)),
+		)
+	}
+
+	if !rs.ServerSideEncryption.IsNull() && !rs.ServerSideEncryption.IsUnknown() {
+		if !slices.Contains(redshiftServerSideEncryptions, rs.ServerSideEncryption.ValueString()) {
+			diags.AddAttributeError(
+				block.AtName("server_side_encryption"),
+				"Invalid server-side encryption",
+				fmt.Sprintf("server_side_encryption must be one of %s, got %q.",
+					quotedList(redshiftServerSideEncryptions), rs.ServerSideEncryption.ValueString()),
+			)
+		}
+		if !unknown && len(set) == 0 {
+			diags.AddAttributeError(
+				block.AtName("server_side_encryption"),
+				"Invalid server-side encryption",
+				"server_side_encryption requires S3 staging to be configured.",
+			)
+		}
+	}
+	return diags
+}
+
+func redshiftToPayload(rs *redshiftModel, payload map[string]interface{}) {
+	setPayloadString(payload, "host", rs.Host)
+	setPayloadInt64(payload, "port", rs.Port)
+	setPayloadString(payload, "database", rs.Database)
+	setPayloadString(payload, "defaultSchema", rs.DefaultSchema)
+	setPayloadString(payload, "username", rs.Username)
+	setPayloadString(payload, "password", rs.Password)
+	setPayloadString(payload, "s3Bucket", rs.S3Bucket)
+	setPayloadString(payload, "s3Region", rs.S3Region)
+	setPayloadString(payload, "accessKeyId", rs.S3AccessKeyID)
+	setPayloadString(payload, "secretAccessKey", rs.S3SecretAccessKey)
+	setPayloadString(payload, "serverSideEncryption", rs.ServerSideEncryption)
+}
+
+// readRedshiftBlock maps a Redshift destination from the API into the redshift
+// block. prev holds the state before the read.
+func readRedshiftBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rs := &redshiftModel{
+		Host:                 resultString(result, "host"),
+		Port:                 resultInt64(result, "port"),
+		Database:             resultString(result, "database"),
+		DefaultSchema:        resultString(result, "defaultSchema"),
+		Username:             resultString(result, "username"),
+		Password:             types.StringNull(),
+		S3Bucket:             resultString(result, "s3Bucket"),
+		S3Region:             resultString(result, "s3Region"),
+		S3AccessKeyID:        resultString(result, "accessKeyId"),
+		S3SecretAccessKey:    types.StringNull(),
+		ServerSideEncryption: resultString(result, "serverSideEncryption"),
+	}
+	// Password and secret access key: API returns masked values — preserve state values.
+	oldRS, d := prev.redshift(ctx)
+	diags.Append(d...)
+	prevTunnel := types.ObjectNull(sshTunnelAttrTypes)
+	if oldRS != nil {
+		rs.Password = oldRS.Password
+		rs.PasswordFromEnv = oldRS.PasswordFromEnv
+		rs.PasswordFile = oldRS.PasswordFile
+		rs.PasswordHash = oldRS.PasswordHash
+		rs.S3SecretAccessKey = oldRS.S3SecretAccessKey
+		prevTunnel = oldRS.SSHTunnel
+	}
+	rs.SSHTunnel, d = readSSHTunnel(ctx, result, prevTunnel)
+	diags.Append(d...)
+
+	objVal, d := types.ObjectValueFrom(ctx, redshiftAttrTypes, rs)
+	diags.Append(d...)
+	return objVal, diags
+}
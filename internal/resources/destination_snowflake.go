@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type snowflakeModel struct {
+	Account              types.String `tfsdk:"account"`
+	Warehouse            types.String `tfsdk:"warehouse"`
+	Database             types.String `tfsdk:"database"`
+	DefaultSchema        types.String `tfsdk:"default_schema"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	PasswordFromEnv      types.String `tfsdk:"password_from_env"`
+	PasswordFile         types.String `tfsdk:"password_file"`
+	PasswordHash         types.String `tfsdk:"password_hash"`
+	PrivateKey           types.String `tfsdk:"private_key"`
+	PrivateKeyPassphrase types.String `tfsdk:"private_key_passphrase"`
+}
+
+var snowflakeAttrTypes = withSecretRefTypes(map[string]attr.Type{
+	"account":                types.StringType,
+	"warehouse":              types.StringType,
+	"database":               types.StringType,
+	"default_schema":         types.StringType,
+	"username":               types.StringType,
+	"password":               types.StringType,
+	"private_key":            types.StringType,
+	"private_key_passphrase": types.StringType,
+}, "password")
+
+func (m *destinationModel) snowflake(ctx context.Context) (*snowflakeModel, diag.Diagnostics) {
+	if m.Snowflake.IsNull() || m.Snowflake.IsUnknown() {
+		return nil, nil
+	}
+	var sf snowflakeModel
+	diags := m.Snowflake.As(ctx, &sf, basetypes.ObjectAsOptions{})
+	return &sf, diags
+}
+
+func snowflakeSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Snowflake destination configuration. Authenticates with either password or private_key.",
+		Attributes: withSecretRef(map[string]schema.Attribute{
+			"account": schema.StringAttribute{
+				Required:    true,
+				Description: "Snowflake account identifier (e.g., myorg-myaccount).",
+			},
+			"warehouse": schema.StringAttribute{
+				Required:    true,
+				Description: "Warehouse used to load data.",
+			},
+			"database": schema.StringAttribute{
+				Required:    true,
+				Description: "Database name.",
+			},
+			"default_schema": schema.StringAttribute{
+				Optional:    true,
+				Description: "Schema tables are created in. Console defaults to PUBLIC.",
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "Snowflake username.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password. API returns masked value; stored in state from user config. Conflicts with password_from_env, password_file and private_key.",
+			},
+			"private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded private key for key-pair authentication. API returns masked value; stored in state from user config.",
+			},
+			"private_key_passphrase": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Passphrase of an encrypted private_key. API returns masked value; stored in state from user config.",
+			},
+		}, "password", "Snowflake password"),
+	}
+}
+
+func validateSnowflake(sf *snowflakeModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	block := path.Root("snowflake")
+
+	if sf.Password.IsUnknown() || sf.PrivateKey.IsUnknown() {
+		return diags
+	}
+	// A password may also come from password_from_env or password_file.
+	password := !sf.Password.IsNull() || !sf.PasswordFromEnv.IsNull() || !sf.PasswordFile.IsNull()
+	switch {
+	case password && !sf.PrivateKey.IsNull():
+		diags.AddAttributeError(
+			block.AtName("private_key"),
+			"Conflicting authentication attributes",
+			"Only one of password or private_key may be set.",
+		)
+	case !password && sf.PrivateKey.IsNull():
+		diags.AddAttributeError(
+			block.AtName("password"),
+			"Missing authentication",
+			"Snowflake destinations must set password, password_from_env, password_file or private_key.",
+		)
+	}
+	if sf.PrivateKey.IsNull() && !sf.PrivateKeyPassphrase.IsNull() {
+		diags.AddAttributeError(
+			block.AtName("private_key_passphrase"),
+			"Invalid authentication configuration",
+			"private_key_passphrase can only be set together with private_key.",
+		)
+	}
+	return diags
+}
+
+func snowflakeToPayload(sf *snowflakeModel, payload map[string]interface{}) {
+	setPayloadString(payload, "account", sf.Account)
+	setPayloadString(payload, "warehouse", sf.Warehouse)
+	setPayloadString(payload, "database", sf.Database)
+	setPayloadString(payload, "defaultSchema", sf.DefaultSchema)
+	setPayloadString(payload, "username", sf.Username)
+	if !sf.PrivateKey.IsNull() {
+		payload["authenticationMethod"] = "key-pair"
+		setPayloadString(payload, "privateKey", sf.PrivateKey)
+		setPayloadString(payload, "privateKeyPassphrase", sf.PrivateKeyPassphrase)
+	} else {
+		payload["authenticationMethod"] = "password"
+		setPayloadString(payload, "password", sf.Password)
+	}
+}
+
+// readSnowflakeBlock maps a Snowflake destination from the API into the
+// snowflake block. prev holds the state before the read.
+func readSnowflakeBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sf := &snowflakeModel{
+		Account:              resultString(result, "account"),
+		Warehouse:            resultString(result, "warehouse"),
+		Database:             resultString(result, "database"),
+		DefaultSchema:        resultString(result, "defaultSchema"),
+		Username:             resultString(result, "username"),
+		Password:             types.StringNull(),
+		PrivateKey:           types.StringNull(),
+		PrivateKeyPassphrase: types.StringNull(),
+	}
+	// Password and private key: API returns masked values — preserve state values.
+	oldSF, d := prev.snowflake(ctx)
+	diags.Append(d...)
+	if oldSF != nil {
+		sf.Password = oldSF.Password
+		sf.PasswordFromEnv = oldSF.PasswordFromEnv
+		sf.PasswordFile = oldSF.PasswordFile
+		sf.PasswordHash = oldSF.PasswordHash
+		sf.PrivateKey = oldSF.PrivateKey
+		sf.PrivateKeyPassphrase = oldSF.PrivateKeyPassphrase
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, snowflakeAttrTypes, sf)
+	diags.Append(d...)
+	return objVal, diags
+}
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// sshTunnelModel is the ssh_tunnel attribute shared by the database
+// destination blocks (clickhouse, postgres, mysql and redshift). Console
+// stores it as a nested sshTunnel object.
+type sshTunnelModel struct {
+	Host       types.String `tfsdk:"host"`
+	Port       types.Int64  `tfsdk:"port"`
+	User       types.String `tfsdk:"user"`
+	PrivateKey types.String `tfsdk:"private_key"`
+	Passphrase types.String `tfsdk:"passphrase"`
+}
+
+var sshTunnelAttrTypes = map[string]attr.Type{
+	"host":        types.StringType,
+	"port":        types.Int64Type,
+	"user":        types.StringType,
+	"private_key": types.StringType,
+	"passphrase":  types.StringType,
+}
+
+// sshTunnel extracts an ssh_tunnel value, or returns nil when it is unset.
+func sshTunnel(ctx context.Context, obj types.Object) (*sshTunnelModel, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+	var t sshTunnelModel
+	diags := obj.As(ctx, &t, basetypes.ObjectAsOptions{})
+	return &t, diags
+}
+
+func sshTunnelSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Connect through an SSH bastion host.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Required:    true,
+				Description: "Bastion host.",
+			},
+			"port": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Bastion SSH port. Console defaults to 22.",
+			},
+			"user": schema.StringAttribute{
+				Required:    true,
+				Description: "SSH user.",
+			},
+			"private_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded SSH private key. API returns masked value; stored in state from user config.",
+			},
+			"passphrase": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Passphrase of an encrypted private_key. API returns masked value; stored in state from user config.",
+			},
+		},
+	}
+}
+
+// validateSSHTunnel checks the ssh_tunnel attribute of the block at p.
+func validateSSHTunnel(ctx context.Context, p path.Path, obj types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+	t, d := sshTunnel(ctx, obj)
+	diags.Append(d...)
+	if t == nil {
+		return diags
+	}
+
+	p = p.AtName("ssh_tunnel")
+	diags.Append(validatePort(p.AtName("port"), t.Port)...)
+	if !t.PrivateKey.IsNull() && !t.PrivateKey.IsUnknown() {
+		if block, _ := pem.Decode([]byte(t.PrivateKey.ValueString())); block == nil {
+			diags.AddAttributeError(
+				p.AtName("private_key"),
+				"Invalid SSH private key",
+				"private_key must be a PEM-encoded private key.",
+			)
+		}
+	}
+	return diags
+}
+
+// sshTunnelToPayload sets payload["sshTunnel"] from an ssh_tunnel value.
+func sshTunnelToPayload(ctx context.Context, obj types.Object, payload map[string]interface{}) error {
+	t, diags := sshTunnel(ctx, obj)
+	if diags.HasError() {
+		return fmt.Errorf("reading ssh_tunnel: %v", diags.Errors())
+	}
+	if t == nil {
+		return nil
+	}
+	tunnel := map[string]interface{}{}
+	setPayloadString(tunnel, "host", t.Host)
+	setPayloadInt64(tunnel, "port", t.Port)
+	setPayloadString(tunnel, "username", t.User)
+	setPayloadString(tunnel, "privateKey", t.PrivateKey)
+	setPayloadString(tunnel, "passphrase", t.Passphrase)
+	payload["sshTunnel"] = tunnel
+	return nil
+}
+
+// readSSHTunnel maps result["sshTunnel"] into an ssh_tunnel value, or null
+// when Console has no tunnel. prev is the ssh_tunnel value before the read.
+func readSSHTunnel(ctx context.Context, result map[string]interface{}, prev types.Object) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	raw, ok := result["sshTunnel"].(map[string]interface{})
+	if !ok || len(raw) == 0 {
+		return types.ObjectNull(sshTunnelAttrTypes), diags
+	}
+
+	t := &sshTunnelModel{
+		Host:       resultString(raw, "host"),
+		Port:       resultInt64(raw, "port"),
+		User:       resultString(raw, "username"),
+		PrivateKey: types.StringNull(),
+		Passphrase: types.StringNull(),
+	}
+	// Private key and passphrase: API returns masked values — preserve state values.
+	old, d := sshTunnel(ctx, prev)
+	diags.Append(d...)
+	if old != nil {
+		t.PrivateKey = old.PrivateKey
+		t.Passphrase = old.Passphrase
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, sshTunnelAttrTypes, t)
+	diags.Append(d...)
+	return objVal, diags
+}
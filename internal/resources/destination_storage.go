@@ -0,0 +1,281 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Object storage destinations (S3 and GCS) share the file layout options below.
+var (
+	storageFormats      = []string{"json", "csv", "parquet"}
+	storageCompressions = []string{"none", "gzip"}
+)
+
+type s3Model struct {
+	Bucket          types.String `tfsdk:"bucket"`
+	Region          types.String `tfsdk:"region"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Folder          types.String `tfsdk:"folder"`
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	Format          types.String `tfsdk:"format"`
+	Compression     types.String `tfsdk:"compression"`
+	FilePerBatch    types.Bool   `tfsdk:"file_per_batch"`
+}
+
+type gcsModel struct {
+	Bucket             types.String `tfsdk:"bucket"`
+	Folder             types.String `tfsdk:"folder"`
+	ServiceAccountJSON types.String `tfsdk:"service_account_json"`
+	Format             types.String `tfsdk:"format"`
+	Compression        types.String `tfsdk:"compression"`
+	FilePerBatch       types.Bool   `tfsdk:"file_per_batch"`
+}
+
+var s3AttrTypes = map[string]attr.Type{
+	"bucket":            types.StringType,
+	"region":            types.StringType,
+	"endpoint":          types.StringType,
+	"folder":            types.StringType,
+	"access_key_id":     types.StringType,
+	"secret_access_key": types.StringType,
+	"format":            types.StringType,
+	"compression":       types.StringType,
+	"file_per_batch":    types.BoolType,
+}
+
+var gcsAttrTypes = map[string]attr.Type{
+	"bucket":               types.StringType,
+	"folder":               types.StringType,
+	"service_account_json": types.StringType,
+	"format":               types.StringType,
+	"compression":          types.StringType,
+	"file_per_batch":       types.BoolType,
+}
+
+func (m *destinationModel) s3(ctx context.Context) (*s3Model, diag.Diagnostics) {
+	if m.S3.IsNull() || m.S3.IsUnknown() {
+		return nil, nil
+	}
+	var s3 s3Model
+	diags := m.S3.As(ctx, &s3, basetypes.ObjectAsOptions{})
+	return &s3, diags
+}
+
+func (m *destinationModel) gcs(ctx context.Context) (*gcsModel, diag.Diagnostics) {
+	if m.GCS.IsNull() || m.GCS.IsUnknown() {
+		return nil, nil
+	}
+	var gcs gcsModel
+	diags := m.GCS.As(ctx, &gcs, basetypes.ObjectAsOptions{})
+	return &gcs, diags
+}
+
+// storageFileAttributes returns the file layout attributes shared by the s3 and gcs blocks.
+func storageFileAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"folder": schema.StringAttribute{
+			Optional:    true,
+			Description: "Path prefix files are written under.",
+		},
+		"format": schema.StringAttribute{
+			Optional:    true,
+			Description: "File format: json (newline-delimited), csv or parquet.",
+		},
+		"compression": schema.StringAttribute{
+			Optional:    true,
+			Description: "File compression: none or gzip.",
+		},
+		"file_per_batch": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Write each batch to a separate file instead of appending to the current one.",
+		},
+	}
+}
+
+func s3SchemaAttribute() schema.SingleNestedAttribute {
+	attrs := storageFileAttributes()
+	attrs["bucket"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Bucket name.",
+	}
+	attrs["region"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Bucket region. At least one of region or endpoint must be set.",
+	}
+	attrs["endpoint"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Custom endpoint URL for S3-compatible storage.",
+	}
+	attrs["access_key_id"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "AWS access key ID. Must be set together with secret_access_key.",
+	}
+	attrs["secret_access_key"] = schema.StringAttribute{
+		Optional:    true,
+		Sensitive:   true,
+		Description: "AWS secret access key. API returns masked value; stored in state from user config.",
+	}
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Amazon S3 (or S3-compatible) destination configuration.",
+		Attributes:  attrs,
+	}
+}
+
+func gcsSchemaAttribute() schema.SingleNestedAttribute {
+	attrs := storageFileAttributes()
+	attrs["bucket"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Bucket name.",
+	}
+	attrs["service_account_json"] = schema.StringAttribute{
+		Required:    true,
+		Sensitive:   true,
+		Description: "Service account JSON key. API returns masked value; stored in state from user config.",
+	}
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Google Cloud Storage destination configuration.",
+		Attributes:  attrs,
+	}
+}
+
+// validateStorageFile checks the file layout options of an s3 or gcs block.
+func validateStorageFile(block path.Path, format, compression types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !format.IsNull() && !format.IsUnknown() && !slices.Contains(storageFormats, format.ValueString()) {
+		diags.AddAttributeError(
+			block.AtName("format"),
+			"Invalid file format",
+			fmt.Sprintf("format must be one of %s, got %q.", quotedList(storageFormats), format.ValueString()),
+		)
+	}
+	if !compression.IsNull() && !compression.IsUnknown() && !slices.Contains(storageCompressions, compression.ValueString()) {
+		diags.AddAttributeError(
+			block.AtName("compression"),
+			"Invalid compression",
+			fmt.Sprintf("compression must be one of %s, got %q.", quotedList(storageCompressions), compression.ValueString()),
+		)
+	}
+	return diags
+}
+
+func validateS3(s3 *s3Model) diag.Diagnostics {
+	block := path.Root("s3")
+	diags := validateStorageFile(block, s3.Format, s3.Compression)
+
+	if s3.Region.IsNull() && s3.Endpoint.IsNull() {
+		diags.AddAttributeError(
+			block.AtName("region"),
+			"Missing bucket location",
+			"S3 destinations must set region or endpoint.",
+		)
+	}
+	if !s3.AccessKeyID.IsUnknown() && !s3.SecretAccessKey.IsUnknown() && s3.AccessKeyID.IsNull() != s3.SecretAccessKey.IsNull() {
+		diags.AddAttributeError(
+			block.AtName("secret_access_key"),
+			"Incomplete access credentials",
+			"access_key_id and secret_access_key must be set together.",
+		)
+	}
+	return diags
+}
+
+func validateGCS(gcs *gcsModel) diag.Diagnostics {
+	block := path.Root("gcs")
+	diags := validateStorageFile(block, gcs.Format, gcs.Compression)
+
+	if !gcs.ServiceAccountJSON.IsNull() && !gcs.ServiceAccountJSON.IsUnknown() {
+		if _, err := decodeJSONObject(gcs.ServiceAccountJSON.ValueString()); err != nil {
+			diags.AddAttributeError(
+				block.AtName("service_account_json"),
+				"Invalid service account JSON",
+				fmt.Sprintf("service_account_json %s.", err.Error()),
+			)
+		}
+	}
+	return diags
+}
+
+func s3ToPayload(s3 *s3Model, payload map[string]interface{}) {
+	setPayloadString(payload, "bucket", s3.Bucket)
+	setPayloadString(payload, "region", s3.Region)
+	setPayloadString(payload, "endpoint", s3.Endpoint)
+	setPayloadString(payload, "folder", s3.Folder)
+	setPayloadString(payload, "accessKeyId", s3.AccessKeyID)
+	setPayloadString(payload, "secretAccessKey", s3.SecretAccessKey)
+	setPayloadString(payload, "format", s3.Format)
+	setPayloadString(payload, "compression", s3.Compression)
+	setPayloadBool(payload, "filePerBatch", s3.FilePerBatch)
+}
+
+func gcsToPayload(gcs *gcsModel, payload map[string]interface{}) {
+	setPayloadString(payload, "bucket", gcs.Bucket)
+	setPayloadString(payload, "folder", gcs.Folder)
+	setPayloadString(payload, "accessKey", gcs.ServiceAccountJSON)
+	setPayloadString(payload, "format", gcs.Format)
+	setPayloadString(payload, "compression", gcs.Compression)
+	setPayloadBool(payload, "filePerBatch", gcs.FilePerBatch)
+}
+
+// readS3Block maps an S3 destination from the API into the s3 block. prev
+// holds the state before the read.
+func readS3Block(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	s3 := &s3Model{
+		Bucket:          resultString(result, "bucket"),
+		Region:          resultString(result, "region"),
+		Endpoint:        resultString(result, "endpoint"),
+		Folder:          resultString(result, "folder"),
+		AccessKeyID:     resultString(result, "accessKeyId"),
+		SecretAccessKey: types.StringNull(),
+		Format:          resultString(result, "format"),
+		Compression:     resultString(result, "compression"),
+		FilePerBatch:    resultBool(result, "filePerBatch"),
+	}
+	// Secret access key: API returns masked value — preserve state value.
+	oldS3, d := prev.s3(ctx)
+	diags.Append(d...)
+	if oldS3 != nil {
+		s3.SecretAccessKey = oldS3.SecretAccessKey
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, s3AttrTypes, s3)
+	diags.Append(d...)
+	return objVal, diags
+}
+
+// readGCSBlock maps a GCS destination from the API into the gcs block. prev
+// holds the state before the read.
+func readGCSBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	gcs := &gcsModel{
+		Bucket:             resultString(result, "bucket"),
+		Folder:             resultString(result, "folder"),
+		ServiceAccountJSON: types.StringNull(),
+		Format:             resultString(result, "format"),
+		Compression:        resultString(result, "compression"),
+		FilePerBatch:       resultBool(result, "filePerBatch"),
+	}
+	// Service account key (accessKey): API returns masked value — preserve state value.
+	oldGCS, d := prev.gcs(ctx)
+	diags.Append(d...)
+	if oldGCS != nil {
+		gcs.ServiceAccountJSON = oldGCS.ServiceAccountJSON
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, gcsAttrTypes, gcs)
+	diags.Append(d...)
+	return objVal, diags
+}
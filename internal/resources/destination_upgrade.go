@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UpgradeState migrates jitsu_destination state from earlier schema versions.
+func (r *destinationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 had no RequiresReplace on destination_type, so an in-place
+		// type change could leave the previous type's block in state. The
+		// version 0 schema also changed across releases as blocks were added,
+		// so the raw state is decoded leniently instead of against a fixed
+		// prior schema.
+		0: {StateUpgrader: upgradeDestinationStateV0},
+	}
+}
+
+func upgradeDestinationStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil {
+		resp.Diagnostics.AddError("Missing prior state", "Cannot upgrade jitsu_destination state: no prior state was provided.")
+		return
+	}
+
+	var schemaResp resource.SchemaResponse
+	(&destinationResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	raw, err := req.RawState.UnmarshalWithOpts(schemaResp.Schema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read prior state", "Cannot upgrade jitsu_destination state: "+err.Error())
+		return
+	}
+
+	var state destinationModel
+	resp.Diagnostics.Append(tfsdk.State{Schema: schemaResp.Schema, Raw: raw}.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.dropStaleBlocks()
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+	if state.CascadeDeleteLinks.IsNull() {
+		state.CascadeDeleteLinks = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// dropStaleBlocks nulls every block that does not configure destination_type.
+func (m *destinationModel) dropStaleBlocks() {
+	keep := destinationBlocks[m.DestinationType.ValueString()]
+	if m.isGeneric() {
+		keep = ""
+	}
+	kept := m.blocks()[keep]
+	m.clearBlocks()
+	if keep == "" {
+		return
+	}
+	m.setBlock(keep, kept)
+}
+
+// setBlock sets the block with the given name.
+func (m *destinationModel) setBlock(name string, v types.Object) {
+	switch name {
+	case "clickhouse":
+		m.ClickHouse = v
+	case "bigquery":
+		m.BigQuery = v
+	case "postgres":
+		m.Postgres = v
+	case "snowflake":
+		m.Snowflake = v
+	case "redshift":
+		m.Redshift = v
+	case "s3":
+		m.S3 = v
+	case "gcs":
+		m.GCS = v
+	case "webhook":
+		m.Webhook = v
+	case "mixpanel":
+		m.Mixpanel = v
+	case "amplitude":
+		m.Amplitude = v
+	case "posthog":
+		m.PostHog = v
+	case "ga4":
+		m.GA4 = v
+	case "mysql":
+		m.MySQL = v
+	}
+}
@@ -0,0 +1,312 @@
+package resources
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func destinationSchema(t *testing.T, ctx context.Context) schema.Schema {
+	t.Helper()
+	var resp resource.SchemaResponse
+	(&destinationResource{}).Schema(ctx, resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+func TestDestinationUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	s := destinationSchema(t, ctx)
+
+	// A version 0 state written before config and most blocks existed, with a
+	// stale clickhouse block left behind by an in-place type change.
+	rawState := `{
+		"workspace_id": "workspace-id",
+		"id": "destination-id",
+		"name": "Warehouse",
+		"destination_type": "bigquery",
+		"clickhouse": {
+			"protocol": "https",
+			"hosts": ["clickhouse:8443"],
+			"username": "default",
+			"password": "secret",
+			"password_wo": null,
+			"password_wo_version": null,
+			"database": null,
+			"cluster": null
+		},
+		"bigquery": {
+			"credentials": "{\"type\":\"service_account\"}",
+			"credentials_wo": null,
+			"credentials_wo_version": null,
+			"project_id": "my-project",
+			"bq_dataset": "events"
+		},
+		"removed_attribute": "ignored"
+	}`
+
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(rawState)}}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	upgrader := (&destinationResource{}).UpgradeState(ctx)[0]
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var state destinationModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading upgraded state: %v", diags)
+	}
+	if !state.ClickHouse.IsNull() {
+		t.Fatal("stale clickhouse block should be dropped")
+	}
+	bq, diags := state.bigquery(ctx)
+	if diags.HasError() || bq == nil {
+		t.Fatalf("expected bigquery block, diagnostics: %v", diags)
+	}
+	if bq.ProjectID.ValueString() != "my-project" || bq.Credentials.ValueString() != `{"type":"service_account"}` {
+		t.Fatalf("bigquery block not carried over: %+v", bq)
+	}
+	if !bq.Location.IsNull() {
+		t.Fatal("attributes added after version 0 should be null")
+	}
+	if !state.Config.IsNull() || !state.Postgres.IsNull() {
+		t.Fatal("attributes missing from version 0 state should be null")
+	}
+	if state.DeletionProtection.IsNull() || state.DeletionProtection.ValueBool() {
+		t.Fatalf("deletion_protection should default to false, got %v", state.DeletionProtection)
+	}
+}
+
+func TestDestinationModifyPlan_WarnsOnTypeChange(t *testing.T) {
+	ctx := context.Background()
+	s := destinationSchema(t, ctx)
+
+	newState := func(destType string) tfsdk.State {
+		m := newDestinationState("workspace-id", "destination-id")
+		m.Name = types.StringValue("Warehouse")
+		m.DestinationType = types.StringValue(destType)
+		st := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+		if diags := st.Set(ctx, m); diags.HasError() {
+			t.Fatalf("unexpected diagnostics building state: %v", diags)
+		}
+		return st
+	}
+
+	tests := map[string]struct {
+		planType string
+		warnings int
+	}{
+		"same type":    {planType: "clickhouse", warnings: 0},
+		"type changed": {planType: "bigquery", warnings: 1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			state := newState("clickhouse")
+			plan := newState(tc.planType)
+			req := resource.ModifyPlanRequest{State: state, Plan: tfsdk.Plan{Schema: s, Raw: plan.Raw}}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			(&destinationResource{}).ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount(); got != tc.warnings {
+				t.Fatalf("expected %d warnings, got %d: %v", tc.warnings, got, resp.Diagnostics)
+			}
+			if tc.warnings > 0 && !strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), "replace_triggered_by") {
+				t.Fatalf("warning should mention replace_triggered_by: %v", resp.Diagnostics.Warnings()[0].Detail())
+			}
+		})
+	}
+}
+
+func TestDestinationModifyPlan_TypeChangeWithLiveLinks(t *testing.T) {
+	ctx := context.Background()
+	s := destinationSchema(t, ctx)
+	var deleted []string
+	r := &destinationResource{client: client.New(linkServer(t, &deleted).URL, "token", "", "test")}
+
+	tests := map[string]struct {
+		cascade bool
+		want    string
+	}{
+		"without cascade": {cascade: false, want: "replacement fails while a live link"},
+		"with cascade":    {cascade: true, want: "deleted with the old destination"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			newState := func(destType string) tfsdk.State {
+				m := newDestinationState("workspace-id", "destination-id")
+				m.Name = types.StringValue("Warehouse")
+				m.DestinationType = types.StringValue(destType)
+				m.CascadeDeleteLinks = types.BoolValue(tc.cascade)
+				st := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+				if diags := st.Set(ctx, m); diags.HasError() {
+					t.Fatalf("unexpected diagnostics building state: %v", diags)
+				}
+				return st
+			}
+			req := resource.ModifyPlanRequest{State: newState("clickhouse"), Plan: tfsdk.Plan{Schema: s, Raw: newState("bigquery").Raw}}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			// One warning covers both the replacement and the links.
+			if resp.Diagnostics.WarningsCount() != 1 {
+				t.Fatalf("expected one warning, got %v", resp.Diagnostics)
+			}
+			detail := resp.Diagnostics.Warnings()[0].Detail()
+			for _, want := range []string{tc.want, "link-1", "link-2", "replace_triggered_by"} {
+				if !strings.Contains(detail, want) {
+					t.Fatalf("warning should mention %q, got %q", want, detail)
+				}
+			}
+		})
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("planning must not delete links, deleted %v", deleted)
+	}
+}
+
+func TestDestinationModifyPlan_ValidatesSecretRefs(t *testing.T) {
+	ctx := context.Background()
+	s := destinationSchema(t, ctx)
+	file := filepath.Join(t.TempDir(), "key.json")
+	if err := os.WriteFile(file, []byte(`{"type": "service_account", "project_id": "other-project"}`+"\n"), 0o600); err != nil {
+		t.Fatalf("writing key file: %v", err)
+	}
+
+	for name, projectID := range map[string]string{"matching project": "other-project", "other project": "my-project"} {
+		t.Run(name, func(t *testing.T) {
+			m := newDestinationState("workspace-id", "destination-id")
+			m.Name = types.StringValue("Warehouse")
+			m.DestinationType = types.StringValue("bigquery")
+			m.BigQuery = mustBigqueryObject(t, ctx, &bigqueryModel{
+				CredentialsFile: types.StringValue(file),
+				CredentialsHash: types.StringUnknown(),
+				ProjectID:       types.StringValue(projectID),
+				BQDataset:       types.StringValue("events"),
+			})
+			plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := plan.Set(ctx, m); diags.HasError() {
+				t.Fatalf("unexpected diagnostics building plan: %v", diags)
+			}
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			req := resource.ModifyPlanRequest{State: state, Plan: plan}
+			resp := resource.ModifyPlanResponse{Plan: plan}
+
+			(&destinationResource{}).ModifyPlan(ctx, req, &resp)
+			wantError := projectID == "my-project"
+			if resp.Diagnostics.HasError() != wantError {
+				t.Fatalf("expected error = %t, got %v", wantError, resp.Diagnostics)
+			}
+			if wantError && resp.Diagnostics.Errors()[0].Summary() != "Service account project mismatch" {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDestinationModifyPlan_OutOfBandChange(t *testing.T) {
+	ctx := context.Background()
+	s := destinationSchema(t, ctx)
+
+	tests := map[string]struct {
+		updatedAt     types.String
+		lastAppliedAt types.String
+		wantUnknown   bool
+	}{
+		"unchanged":         {updatedAt: types.StringValue("2026-01-01T00:00:00Z"), lastAppliedAt: types.StringValue("2026-01-01T00:00:00Z")},
+		"changed in UI":     {updatedAt: types.StringValue("2026-02-01T00:00:00Z"), lastAppliedAt: types.StringValue("2026-01-01T00:00:00Z"), wantUnknown: true},
+		"imported":          {updatedAt: types.StringValue("2026-02-01T00:00:00Z"), lastAppliedAt: types.StringNull()},
+		"no Console update": {updatedAt: types.StringNull(), lastAppliedAt: types.StringValue("2026-01-01T00:00:00Z")},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := newDestinationState("workspace-id", "destination-id")
+			m.Name = types.StringValue("Warehouse")
+			m.DestinationType = types.StringValue("clickhouse")
+			m.UpdatedAt = tc.updatedAt
+			m.LastAppliedAt = tc.lastAppliedAt
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := state.Set(ctx, m); diags.HasError() {
+				t.Fatalf("unexpected diagnostics building state: %v", diags)
+			}
+
+			req := resource.ModifyPlanRequest{State: state, Plan: tfsdk.Plan{Schema: s, Raw: state.Raw}}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+			(&destinationResource{}).ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var planned types.String
+			resp.Plan.GetAttribute(ctx, path.Root("last_applied_at"), &planned)
+			if planned.IsUnknown() != tc.wantUnknown {
+				t.Fatalf("expected last_applied_at unknown=%t, got %v", tc.wantUnknown, planned)
+			}
+			if tc.wantUnknown && resp.Diagnostics.WarningsCount() != 1 {
+				t.Fatalf("expected a warning, got %v", resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDestinationModifyPlan_WarnsAboutLinksOnDestroy(t *testing.T) {
+	ctx := context.Background()
+	s := destinationSchema(t, ctx)
+	var deleted []string
+	r := &destinationResource{client: client.New(linkServer(t, &deleted).URL, "token", "", "test")}
+
+	for name, cascade := range map[string]bool{"without cascade": false, "with cascade": true} {
+		t.Run(name, func(t *testing.T) {
+			m := newDestinationState("workspace-id", "destination-id")
+			m.Name = types.StringValue("Warehouse")
+			m.DestinationType = types.StringValue("clickhouse")
+			m.CascadeDeleteLinks = types.BoolValue(cascade)
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			if diags := state.Set(ctx, m); diags.HasError() {
+				t.Fatalf("unexpected diagnostics building state: %v", diags)
+			}
+			destroy := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+			req := resource.ModifyPlanRequest{State: state, Plan: destroy}
+			resp := resource.ModifyPlanResponse{Plan: destroy}
+
+			r.ModifyPlan(ctx, req, &resp)
+			if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+				t.Fatalf("expected one warning, got %v", resp.Diagnostics)
+			}
+			warning := resp.Diagnostics.Warnings()[0]
+			if !strings.Contains(warning.Detail(), "link-1") || !strings.Contains(warning.Detail(), "link-2") {
+				t.Fatalf("warning should list the links, got %q", warning.Detail())
+			}
+			if cascade != strings.Contains(warning.Summary(), "will be deleted") {
+				t.Fatalf("unexpected warning for cascade_delete_links = %t: %q", cascade, warning.Summary())
+			}
+		})
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("planning must not delete links, deleted %v", deleted)
+	}
+}
@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var webhookMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+
+type webhookModel struct {
+	URL             types.String `tfsdk:"url"`
+	Method          types.String `tfsdk:"method"`
+	PayloadTemplate types.String `tfsdk:"payload_template"`
+	Headers         types.Map    `tfsdk:"headers"`
+}
+
+type webhookHeaderModel struct {
+	Value     types.String `tfsdk:"value"`
+	Sensitive types.Bool   `tfsdk:"sensitive"`
+}
+
+var webhookHeaderAttrTypes = map[string]attr.Type{
+	"value":     types.StringType,
+	"sensitive": types.BoolType,
+}
+
+var webhookAttrTypes = map[string]attr.Type{
+	"url":              types.StringType,
+	"method":           types.StringType,
+	"payload_template": types.StringType,
+	"headers":          types.MapType{ElemType: types.ObjectType{AttrTypes: webhookHeaderAttrTypes}},
+}
+
+func (m *destinationModel) webhook(ctx context.Context) (*webhookModel, diag.Diagnostics) {
+	if m.Webhook.IsNull() || m.Webhook.IsUnknown() {
+		return nil, nil
+	}
+	var wh webhookModel
+	diags := m.Webhook.As(ctx, &wh, basetypes.ObjectAsOptions{})
+	return &wh, diags
+}
+
+// headers returns the webhook headers keyed by name, or nil when unset.
+func (m *webhookModel) headers(ctx context.Context) (map[string]webhookHeaderModel, diag.Diagnostics) {
+	if m.Headers.IsNull() || m.Headers.IsUnknown() {
+		return nil, nil
+	}
+	headers := make(map[string]webhookHeaderModel, len(m.Headers.Elements()))
+	diags := m.Headers.ElementsAs(ctx, &headers, false)
+	return headers, diags
+}
+
+func webhookSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: "Webhook destination configuration.",
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required:    true,
+				Description: "URL events are sent to.",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Description: "HTTP method: GET, POST, PUT, PATCH or DELETE. Console defaults to POST.",
+			},
+			"payload_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "Template used to build the request body. Console sends the event as JSON when unset.",
+			},
+			"headers": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "HTTP headers keyed by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "Header value. Every header value is redacted in plan output, whether or not sensitive is set.",
+						},
+						"sensitive": schema.BoolAttribute{
+							Optional: true,
+							Description: "Whether the header holds a secret. Console returns masked values for secrets, " +
+								"so sensitive header values are stored in state from user config. It does not affect plan " +
+								"output, where every header value is redacted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateWebhook(ctx context.Context, wh *webhookModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	block := path.Root("webhook")
+
+	if !wh.Method.IsNull() && !wh.Method.IsUnknown() && !slices.Contains(webhookMethods, wh.Method.ValueString()) {
+		diags.AddAttributeError(
+			block.AtName("method"),
+			"Invalid HTTP method",
+			fmt.Sprintf("method must be one of %s, got %q.", quotedList(webhookMethods), wh.Method.ValueString()),
+		)
+	}
+
+	headers, d := wh.headers(ctx)
+	diags.Append(d...)
+	for name := range headers {
+		if name == "" || strings.ContainsAny(name, ": \t\r\n") {
+			diags.AddAttributeError(
+				block.AtName("headers").AtMapKey(name),
+				"Invalid header name",
+				fmt.Sprintf("Header name %q must be non-empty and cannot contain colons or whitespace.", name),
+			)
+		}
+	}
+	return diags
+}
+
+func webhookToPayload(ctx context.Context, wh *webhookModel, payload map[string]interface{}) error {
+	setPayloadString(payload, "url", wh.URL)
+	setPayloadString(payload, "method", wh.Method)
+	setPayloadString(payload, "payload", wh.PayloadTemplate)
+
+	headers, diags := wh.headers(ctx)
+	if diags.HasError() {
+		return fmt.Errorf("reading headers: %v", diags.Errors())
+	}
+	if headers != nil {
+		// Console stores headers as "Name: value" lines.
+		names := make([]string, 0, len(headers))
+		for name := range headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		lines := make([]string, 0, len(names))
+		for _, name := range names {
+			lines = append(lines, name+": "+headers[name].Value.ValueString())
+		}
+		payload["headers"] = lines
+	}
+	return nil
+}
+
+// readWebhookBlock maps a webhook destination from the API into the webhook
+// block. prev holds the state before the read.
+func readWebhookBlock(ctx context.Context, result map[string]interface{}, prev *destinationModel) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	wh := &webhookModel{
+		URL:             resultString(result, "url"),
+		Method:          resultString(result, "method"),
+		PayloadTemplate: resultString(result, "payload"),
+	}
+
+	var oldHeaders map[string]webhookHeaderModel
+	oldWH, d := prev.webhook(ctx)
+	diags.Append(d...)
+	if oldWH != nil {
+		oldHeaders, d = oldWH.headers(ctx)
+		diags.Append(d...)
+	}
+
+	headerType := types.ObjectType{AttrTypes: webhookHeaderAttrTypes}
+	lines, _ := result["headers"].([]interface{})
+	if len(lines) == 0 {
+		wh.Headers = types.MapNull(headerType)
+		// Console drops an empty header list; keep headers = {} from state
+		// so it does not show as a permanent diff.
+		if oldWH != nil && !oldWH.Headers.IsNull() && !oldWH.Headers.IsUnknown() && len(oldWH.Headers.Elements()) == 0 {
+			wh.Headers = oldWH.Headers
+		}
+	} else {
+		headers := make(map[string]webhookHeaderModel, len(lines))
+		for _, l := range lines {
+			line, ok := l.(string)
+			if !ok {
+				continue
+			}
+			name, value, _ := strings.Cut(line, ":")
+			name = strings.TrimSpace(name)
+			// Only drop the space webhookToPayload puts after the colon, so
+			// values with their own leading or trailing spaces round-trip.
+			header := webhookHeaderModel{
+				Value:     types.StringValue(strings.TrimPrefix(value, " ")),
+				Sensitive: types.BoolNull(),
+			}
+			if old, ok := oldHeaders[name]; ok {
+				header.Sensitive = old.Sensitive
+				// Sensitive headers: API returns masked values — preserve state value.
+				if old.Sensitive.ValueBool() {
+					header.Value = old.Value
+				}
+			}
+			headers[name] = header
+		}
+		headerMap, d := types.MapValueFrom(ctx, headerType, headers)
+		diags.Append(d...)
+		wh.Headers = headerMap
+	}
+
+	objVal, d := types.ObjectValueFrom(ctx, webhookAttrTypes, wh)
+	diags.Append(d...)
+	return objVal, diags
+}
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +16,7 @@ import (
 var (
 	_ resource.Resource                = &functionResource{}
 	_ resource.ResourceWithImportState = &functionResource{}
+	_ resource.ResourceWithIdentity    = &functionResource{}
 )
 
 type functionResource struct {
@@ -28,6 +30,22 @@ type functionModel struct {
 	Code        types.String `tfsdk:"code"`
 }
 
+// functionIDPattern is the JS identifier rule Console applies to function IDs,
+// since IDs are referenced from generated code.
+var functionIDPattern = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func isValidFunctionID(id string) bool {
+	return functionIDPattern.MatchString(id)
+}
+
+// functionRefPrefix is the prefix links put before a function ID to reference it.
+const functionRefPrefix = "udf."
+
+// functionRef returns the reference links use for a function ID.
+func functionRef(id string) string {
+	return functionRefPrefix + id
+}
+
 func NewFunctionResource() resource.Resource {
 	return &functionResource{}
 }
@@ -66,6 +84,10 @@ func (r *functionResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *functionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = objectIdentitySchema("Function ID.")
+}
+
 func (r *functionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.client = configureClient(req, resp)
 }
@@ -92,6 +114,7 @@ func (r *functionResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, plan.WorkspaceID, plan.ID)...)
 }
 
 func (r *functionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -119,6 +142,7 @@ func (r *functionResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
 }
 
 func (r *functionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -143,6 +167,7 @@ func (r *functionResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, plan.WorkspaceID, plan.ID)...)
 }
 
 func (r *functionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -158,7 +183,11 @@ func (r *functionResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *functionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := splitImportID(req.ID, 2)
+	parts, diags := importObjectID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if parts == nil {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: workspace_id/function_id")
 		return
@@ -186,4 +215,5 @@ func (r *functionResource) ImportState(ctx context.Context, req resource.ImportS
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
 }
@@ -20,6 +20,7 @@ import (
 var (
 	_ resource.Resource                = &linkResource{}
 	_ resource.ResourceWithImportState = &linkResource{}
+	_ resource.ResourceWithIdentity    = &linkResource{}
 )
 
 type linkResource struct {
@@ -167,6 +168,10 @@ func (r *linkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (r *linkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = objectIdentitySchema("Link ID (auto-generated by Console).")
+}
+
 func (r *linkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.client = configureClient(req, resp)
 }
@@ -213,7 +218,7 @@ func (r *linkResource) buildPayload(ctx context.Context, plan *linkModel) (map[s
 		}
 		funcs := make([]map[string]string, len(funcIDs))
 		for i, fid := range funcIDs {
-			funcs[i] = map[string]string{"functionId": "udf." + fid}
+			funcs[i] = map[string]string{"functionId": functionRef(fid)}
 		}
 		data["functions"] = funcs
 	}
@@ -256,6 +261,7 @@ func (r *linkResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, plan.WorkspaceID, plan.ID)...)
 }
 
 func (r *linkResource) findLinkByID(ctx context.Context, workspaceID, id string) (map[string]interface{}, error) {
@@ -388,7 +394,7 @@ func readLinkIntoState(ctx context.Context, link map[string]interface{}, state *
 		for _, f := range funcs {
 			if fm, ok := f.(map[string]interface{}); ok {
 				if fid, ok := fm["functionId"].(string); ok {
-					funcIDs = append(funcIDs, strings.TrimPrefix(fid, "udf."))
+					funcIDs = append(funcIDs, strings.TrimPrefix(fid, functionRefPrefix))
 				}
 			}
 		}
@@ -425,6 +431,7 @@ func (r *linkResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	resp.Diagnostics.Append(readLinkIntoState(ctx, link, &state)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
 }
 
 func (r *linkResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -445,6 +452,11 @@ func (r *linkResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *linkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		r.importByIdentity(ctx, req, resp)
+		return
+	}
+
 	parts := splitImportID(req.ID, 3)
 	if parts == nil {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: workspace_id/from_id/to_id")
@@ -468,4 +480,37 @@ func (r *linkResource) ImportState(ctx context.Context, req resource.ImportState
 	}
 	resp.Diagnostics.Append(readLinkIntoState(ctx, link, &state)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
+}
+
+// importByIdentity imports a link addressed by its workspace_id/id identity rather
+// than the legacy workspace_id/from_id/to_id import ID.
+func (r *linkResource) importByIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity objectIdentityModel
+	resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	wsID, id := identity.WorkspaceID.ValueString(), identity.ID.ValueString()
+	if wsID == "" || id == "" {
+		resp.Diagnostics.AddError("Invalid import identity", "Both workspace_id and id must be set.")
+		return
+	}
+
+	link, err := r.findLinkByID(ctx, wsID, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing link", err.Error())
+		return
+	}
+	if link == nil {
+		resp.Diagnostics.AddError("Link not found", fmt.Sprintf("Link %s not found in workspace %s", id, wsID))
+		return
+	}
+
+	state := linkModel{
+		WorkspaceID: types.StringValue(wsID),
+	}
+	resp.Diagnostics.Append(readLinkIntoState(ctx, link, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
 }
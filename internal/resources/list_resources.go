@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResourceWithConfigure = &configObjectListResource{}
+)
+
+// configObjectListResource implements `terraform query` discovery for one
+// workspace-scoped config object type. Each result carries the object's
+// identity and, when requested, its full state as Read would produce it.
+type configObjectListResource struct {
+	client *client.Client
+
+	// typeName is the managed resource type suffix (e.g. "stream").
+	typeName string
+	// resourceType is the Console config API object type (e.g. "stream").
+	resourceType string
+	// displayName returns the human-readable name of a listed object.
+	displayName func(obj map[string]interface{}) string
+	// toState converts a listed object into the managed resource state model.
+	toState func(ctx context.Context, workspaceID string, obj map[string]interface{}, result *list.ListResult) any
+}
+
+type listConfigModel struct {
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+}
+
+func NewStreamListResource() list.ListResource {
+	return &configObjectListResource{
+		typeName:     "stream",
+		resourceType: "stream",
+		displayName:  objectName,
+		toState: func(_ context.Context, workspaceID string, obj map[string]interface{}, _ *list.ListResult) any {
+			id, _ := obj["id"].(string)
+			state := &streamModel{
+				WorkspaceID: types.StringValue(workspaceID),
+				ID:          types.StringValue(id),
+				Name:        stringOrNull(obj["name"]),
+				// Keys not available — API returns hashed values
+				PublicKeys:         types.ListNull(types.ObjectType{AttrTypes: streamKeyAttrTypes}),
+				PrivateKeys:        types.ListNull(types.ObjectType{AttrTypes: streamKeyAttrTypes}),
+				DeletionProtection: types.BoolValue(false),
+				CascadeDeleteLinks: types.BoolValue(false),
+			}
+			return state
+		},
+	}
+}
+
+func NewDestinationListResource() list.ListResource {
+	return &configObjectListResource{
+		typeName:     "destination",
+		resourceType: "destination",
+		displayName:  objectName,
+		toState: func(ctx context.Context, workspaceID string, obj map[string]interface{}, result *list.ListResult) any {
+			id, _ := obj["id"].(string)
+			state := newDestinationState(workspaceID, id)
+			result.Diagnostics.Append((&destinationResource{}).readAPIIntoState(ctx, obj, state)...)
+			return state
+		},
+	}
+}
+
+func NewFunctionListResource() list.ListResource {
+	return &configObjectListResource{
+		typeName:     "function",
+		resourceType: "function",
+		displayName:  objectName,
+		toState: func(_ context.Context, workspaceID string, obj map[string]interface{}, _ *list.ListResult) any {
+			id, _ := obj["id"].(string)
+			state := &functionModel{
+				WorkspaceID: types.StringValue(workspaceID),
+				ID:          types.StringValue(id),
+				Name:        stringOrNull(obj["name"]),
+				Code:        stringOrNull(obj["code"]),
+			}
+			return state
+		},
+	}
+}
+
+func NewLinkListResource() list.ListResource {
+	return &configObjectListResource{
+		typeName:     "link",
+		resourceType: "link",
+		displayName: func(obj map[string]interface{}) string {
+			fromID, _ := obj["fromId"].(string)
+			toID, _ := obj["toId"].(string)
+			return fromID + " -> " + toID
+		},
+		toState: func(ctx context.Context, workspaceID string, obj map[string]interface{}, result *list.ListResult) any {
+			state := &linkModel{
+				WorkspaceID: types.StringValue(workspaceID),
+			}
+			result.Diagnostics.Append(readLinkIntoState(ctx, obj, state)...)
+			return state
+		},
+	}
+}
+
+// objectName returns the Console display name of an object, falling back to its ID.
+func objectName(obj map[string]interface{}) string {
+	if name, ok := obj["name"].(string); ok && name != "" {
+		return name
+	}
+	id, _ := obj["id"].(string)
+	return id
+}
+
+func (r *configObjectListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.typeName
+}
+
+func (r *configObjectListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Lists the %ss of a Jitsu workspace.", r.typeName),
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Jitsu workspace ID to list objects from.",
+			},
+		},
+	}
+}
+
+func (r *configObjectListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = configureClient(req, resp)
+}
+
+func (r *configObjectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	workspaceID := config.WorkspaceID.ValueString()
+
+	objects, err := r.client.List(ctx, workspaceID, r.resourceType)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error listing %ss", r.typeName), err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, obj := range objects {
+			if deleted, _ := obj["deleted"].(bool); deleted {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			id, _ := obj["id"].(string)
+			result := req.NewListResult(ctx)
+			result.DisplayName = r.displayName(obj)
+			result.Diagnostics.Append(setObjectIdentity(ctx, result.Identity, types.StringValue(workspaceID), types.StringValue(id))...)
+			if req.IncludeResource {
+				state := r.toState(ctx, workspaceID, obj, &result)
+				result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func listStreams(t *testing.T, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/workspace-id/config/stream" {
+			t.Errorf("unexpected request path %q", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"objects": [
+			{"id": "site", "name": "Website"},
+			{"id": "old", "name": "Old", "deleted": true},
+			{"id": "backend", "name": "Backend"}
+		]}`))
+	}))
+	defer server.Close()
+
+	lr := NewStreamListResource().(*configObjectListResource)
+	lr.client = client.New(server.URL, "token", "", "test")
+
+	var configSchema list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	config := tfsdk.Config{
+		Schema: configSchema.Schema,
+		Raw: tftypes.NewValue(configSchema.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"workspace_id": tftypes.NewValue(tftypes.String, "workspace-id"),
+		}),
+	}
+
+	var resourceSchema resource.SchemaResponse
+	(&streamResource{}).Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	(&streamResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	req := list.ListRequest{
+		Config:                 config,
+		IncludeResource:        true,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}
+	var stream list.ListResultsStream
+	lr.List(ctx, req, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestStreamListResource_SkipsDeletedAndIncludesResource(t *testing.T) {
+	ctx := context.Background()
+
+	results := listStreams(t, 0)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[0].DisplayName != "Website" {
+		t.Fatalf("display name mismatch: got %q", results[0].DisplayName)
+	}
+
+	var identity objectIdentityModel
+	if diags := results[0].Identity.Get(ctx, &identity); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading identity: %v", diags)
+	}
+	if identity.WorkspaceID.ValueString() != "workspace-id" || identity.ID.ValueString() != "site" {
+		t.Fatalf("identity mismatch: got %v/%v", identity.WorkspaceID, identity.ID)
+	}
+
+	var state streamModel
+	if diags := results[1].Resource.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading resource: %v", diags)
+	}
+	if state.ID.ValueString() != "backend" || state.Name.ValueString() != "Backend" {
+		t.Fatalf("resource mismatch: got %v/%v", state.ID, state.Name)
+	}
+	if !state.PublicKeys.Equal(types.ListNull(types.ObjectType{AttrTypes: streamKeyAttrTypes})) {
+		t.Fatalf("public_keys should be null, got %v", state.PublicKeys)
+	}
+	// Defaults match ImportState, so generated config plans no update.
+	if !state.DeletionProtection.Equal(types.BoolValue(false)) {
+		t.Fatalf("deletion_protection should be false, got %v", state.DeletionProtection)
+	}
+	if !state.CascadeDeleteLinks.Equal(types.BoolValue(false)) {
+		t.Fatalf("cascade_delete_links should be false, got %v", state.CascadeDeleteLinks)
+	}
+}
+
+func TestStreamListResource_RespectsLimit(t *testing.T) {
+	results := listStreams(t, 1)
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+}
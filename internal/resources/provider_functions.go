@@ -0,0 +1,159 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &keyHintFunction{}
+	_ function.Function = &functionRefFunction{}
+	_ function.Function = &isValidFunctionIDFunction{}
+	_ function.Function = &parseImportIDFunction{}
+)
+
+type keyHintFunction struct{}
+
+func NewKeyHintFunction() function.Function {
+	return &keyHintFunction{}
+}
+
+func (f *keyHintFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "key_hint"
+}
+
+func (f *keyHintFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute the Console hint for a stream key",
+		Description: "Returns the hint Console displays for a stream write key: the first and last three characters joined by \"*\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "plaintext",
+				Description: "Plaintext key value.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *keyHintFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var plaintext string
+	resp.Error = req.Arguments.Get(ctx, &plaintext)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, keyHintFromPlaintext(plaintext))
+}
+
+type functionRefFunction struct{}
+
+func NewFunctionRefFunction() function.Function {
+	return &functionRefFunction{}
+}
+
+func (f *functionRefFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "function_ref"
+}
+
+func (f *functionRefFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a link function reference",
+		Description: "Returns the reference Console links use for a function ID (the ID with a \"udf.\" prefix).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Function ID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *functionRefFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, functionRef(id))
+}
+
+type isValidFunctionIDFunction struct{}
+
+func NewIsValidFunctionIDFunction() function.Function {
+	return &isValidFunctionIDFunction{}
+}
+
+func (f *isValidFunctionIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_valid_function_id"
+}
+
+func (f *isValidFunctionIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Check a function ID",
+		Description: "Returns true if the value is a valid JS identifier and can be used as a jitsu_function ID.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Candidate function ID.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *isValidFunctionIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, isValidFunctionID(id))
+}
+
+type parseImportIDFunction struct{}
+
+func NewParseImportIDFunction() function.Function {
+	return &parseImportIDFunction{}
+}
+
+func (f *parseImportIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_import_id"
+}
+
+func (f *parseImportIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a resource import ID",
+		Description: "Splits an import ID into its named segments using the same rules as terraform import. " +
+			"Returns {id} for workspace, {workspace_id, id} for function, destination and stream, " +
+			"{workspace_id, from_id, to_id} for link and {workspace_id, type, id} for config_object.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kind",
+				Description: "Resource kind: workspace, function, destination, stream, link or config_object.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "Import ID to parse.",
+			},
+		},
+		Return: function.MapReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *parseImportIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kind, id string
+	resp.Error = req.Arguments.Get(ctx, &kind, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	parts, err := parseImportID(kind, id)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, parts)
+}
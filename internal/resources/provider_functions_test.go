@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runFunction(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) function.RunResponse {
+	t.Helper()
+	resp := function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp
+}
+
+func TestKeyHintFunction(t *testing.T) {
+	resp := runFunction(t, &keyHintFunction{}, types.StringUnknown(), types.StringValue("browser-secret-1234"))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	if got := resp.Result.Value(); !got.Equal(types.StringValue("bro*234")) {
+		t.Fatalf("key_hint returned %v", got)
+	}
+}
+
+func TestFunctionRefFunction(t *testing.T) {
+	resp := runFunction(t, &functionRefFunction{}, types.StringUnknown(), types.StringValue("enrich_event"))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	if got := resp.Result.Value(); !got.Equal(types.StringValue("udf.enrich_event")) {
+		t.Fatalf("function_ref returned %v", got)
+	}
+}
+
+func TestIsValidFunctionID(t *testing.T) {
+	cases := map[string]bool{
+		"enrich_event": true,
+		"_private":     true,
+		"$helper2":     true,
+		"enrich-event": false,
+		"2fast":        false,
+		"":             false,
+		"with space":   false,
+	}
+
+	for id, want := range cases {
+		resp := runFunction(t, &isValidFunctionIDFunction{}, types.BoolUnknown(), types.StringValue(id))
+		if resp.Error != nil {
+			t.Fatalf("unexpected error for %q: %v", id, resp.Error)
+		}
+		if got := resp.Result.Value(); !got.Equal(types.BoolValue(want)) {
+			t.Fatalf("is_valid_function_id(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestParseImportIDFunction(t *testing.T) {
+	resp := runFunction(
+		t,
+		&parseImportIDFunction{},
+		types.MapUnknown(types.StringType),
+		types.StringValue("destination"),
+		types.StringValue("workspace/dest-clickhouse"),
+	)
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"workspace_id": types.StringValue("workspace"),
+		"id":           types.StringValue("dest-clickhouse"),
+	})
+	if got := resp.Result.Value(); !got.Equal(want) {
+		t.Fatalf("parse_import_id returned %v", got)
+	}
+}
+
+func TestParseImportIDFunction_InvalidID(t *testing.T) {
+	resp := runFunction(
+		t,
+		&parseImportIDFunction{},
+		types.MapUnknown(types.StringType),
+		types.StringValue("link"),
+		types.StringValue("workspace/stream"),
+	)
+	if resp.Error == nil {
+		t.Fatal("expected error for link import ID with two segments")
+	}
+}
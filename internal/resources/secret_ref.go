@@ -0,0 +1,206 @@
+package resources
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secret references let a secret attribute (e.g. password) be read from an
+// environment variable (<attr>_from_env) or a file (<attr>_file) by the
+// provider instead of being passed through Terraform. The value is resolved
+// when planning, to record its hash in <attr>_hash, and again when applying,
+// when it is sent to Console. Only the hash is stored in state, as an
+// HMAC-SHA256 keyed with a random salt kept next to it, so a leaked state file
+// cannot be used to test guesses against a table of precomputed hashes.
+
+// withSecretRef adds the <name>_from_env, <name>_file and <name>_hash
+// attributes to attrs and returns it.
+func withSecretRef(attrs map[string]schema.Attribute, name, what string) map[string]schema.Attribute {
+	attrs[name+"_from_env"] = schema.StringAttribute{
+		Optional: true,
+		Description: fmt.Sprintf("Name of an environment variable holding the %s. Read by the provider when planning "+
+			"and applying; only a hash is stored in state. Conflicts with %s.", what, name),
+	}
+	attrs[name+"_file"] = schema.StringAttribute{
+		Optional: true,
+		Description: fmt.Sprintf("Path to a file holding the %s. Trailing newlines are ignored. Read by the provider "+
+			"when planning and applying; only a hash is stored in state. Conflicts with %s.", what, name),
+	}
+	attrs[name+"_hash"] = schema.StringAttribute{
+		Computed:    true,
+		Description: fmt.Sprintf("Salted HMAC-SHA256 of the %s read from %s_from_env or %s_file. A new value plans an update.", what, name, name),
+	}
+	return attrs
+}
+
+// withSecretRefTypes adds the attribute types of withSecretRef to attrTypes
+// and returns it.
+func withSecretRefTypes(attrTypes map[string]attr.Type, name string) map[string]attr.Type {
+	attrTypes[name+"_from_env"] = types.StringType
+	attrTypes[name+"_file"] = types.StringType
+	attrTypes[name+"_hash"] = types.StringType
+	return attrTypes
+}
+
+// resolveSecretRef reads the secret referenced by fromEnv or file. ok is
+// false when neither is set.
+func resolveSecretRef(fromEnv, file types.String) (value string, ok bool, err error) {
+	switch {
+	case !fromEnv.IsNull() && !fromEnv.IsUnknown():
+		name := fromEnv.ValueString()
+		v, found := os.LookupEnv(name)
+		if !found {
+			return "", false, fmt.Errorf("environment variable %s is not set", name)
+		}
+		return v, true, nil
+	case !file.IsNull() && !file.IsUnknown():
+		b, err := os.ReadFile(file.ValueString())
+		if err != nil {
+			return "", false, fmt.Errorf("reading secret file: %w", err)
+		}
+		return strings.TrimRight(string(b), "\r\n"), true, nil
+	}
+	return "", false, nil
+}
+
+// secretHashPrefix starts every <attr>_hash value, which is followed by the
+// hex-encoded salt and HMAC separated by a colon.
+const secretHashPrefix = "hmac-sha256:"
+
+// secretHash returns the value stored in <attr>_hash for a resolved secret,
+// keyed with salt.
+func secretHash(value string, salt []byte) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(value))
+	return secretHashPrefix + hex.EncodeToString(salt) + ":" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// newSecretHash returns secretHash of value with a new random salt.
+func newSecretHash(value string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("generating salt: %w", err)
+	}
+	return secretHash(value, salt), nil
+}
+
+// secretHashMatches reports whether hash was computed from value. Hashes in
+// another format, such as the unsalted ones of earlier versions, never match.
+func secretHashMatches(value, hash string) bool {
+	rest, ok := strings.CutPrefix(hash, secretHashPrefix)
+	if !ok {
+		return false
+	}
+	salt, _, ok := strings.Cut(rest, ":")
+	if !ok {
+		return false
+	}
+	saltBytes, err := hex.DecodeString(salt)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal([]byte(secretHash(value, saltBytes)), []byte(hash))
+}
+
+// planSecretHash resolves a secret reference at plan time and returns the
+// value to plan for its hash attribute: null when no reference is set and
+// unknown when the reference is not known yet. prior is the hash in state; it
+// is kept while it still matches the secret, so an unchanged secret plans no
+// update despite the random salt.
+func planSecretHash(p path.Path, fromEnv, file, prior types.String) (types.String, diag.Diagnostics) {
+	_, hash, diags := planSecretValue(p, fromEnv, file, prior)
+	return hash, diags
+}
+
+// planSecretValue is planSecretHash that also returns the secret, so it can be
+// validated when planning. The secret is null whenever the hash is not known.
+func planSecretValue(p path.Path, fromEnv, file, prior types.String) (value, hash types.String, diags diag.Diagnostics) {
+	if fromEnv.IsUnknown() || file.IsUnknown() {
+		return types.StringNull(), types.StringUnknown(), diags
+	}
+	v, ok, err := resolveSecretRef(fromEnv, file)
+	if err != nil {
+		diags.AddAttributeError(p, "Cannot read secret", err.Error())
+		return types.StringNull(), types.StringUnknown(), diags
+	}
+	if !ok {
+		return types.StringNull(), types.StringNull(), diags
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && secretHashMatches(v, prior.ValueString()) {
+		return types.StringValue(v), prior, diags
+	}
+	h, err := newSecretHash(v)
+	if err != nil {
+		diags.AddAttributeError(p, "Cannot hash secret", err.Error())
+		return types.StringNull(), types.StringUnknown(), diags
+	}
+	return types.StringValue(v), types.StringValue(h), diags
+}
+
+// applySecretRef resolves a secret reference at apply time. It fails when the
+// secret no longer matches the hash recorded at plan time, so Console never
+// receives a value the plan did not show.
+func applySecretRef(name string, fromEnv, file, plannedHash types.String) (string, bool, error) {
+	value, ok, err := resolveSecretRef(fromEnv, file)
+	if err != nil {
+		return "", false, fmt.Errorf("resolving %s: %w", name, err)
+	}
+	if ok && !plannedHash.IsNull() && !plannedHash.IsUnknown() && !secretHashMatches(value, plannedHash.ValueString()) {
+		return "", false, fmt.Errorf("%s changed between plan and apply; run terraform plan again", name)
+	}
+	return value, ok, nil
+}
+
+// validateSecretRefConflicts reports an error when more than one way of
+// setting the secret attribute name is used in a block's config.
+func validateSecretRefConflicts(block path.Path, obj types.Object, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return diags
+	}
+	attrs := obj.Attributes()
+	var set []string
+	for _, candidate := range []string{name, name + "_wo", name + "_from_env", name + "_file"} {
+		if v, ok := attrs[candidate]; ok && !v.IsNull() {
+			set = append(set, candidate)
+		}
+	}
+	if len(set) > 1 {
+		diags.AddAttributeError(
+			block.AtName(set[1]),
+			"Conflicting secret attributes",
+			fmt.Sprintf("Only one of %s may be set.", strings.Join(set, ", ")),
+		)
+	}
+	return diags
+}
+
+// secretRefSet reports whether the secret attribute name of a block is set
+// through a reference.
+func secretRefSet(obj types.Object, name string) bool {
+	if obj.IsNull() || obj.IsUnknown() {
+		return false
+	}
+	attrs := obj.Attributes()
+	return !attrs[name+"_from_env"].IsNull() || !attrs[name+"_file"].IsNull()
+}
+
+// objectSecretRef returns the reference attributes of the secret name in obj.
+func objectSecretRef(obj types.Object, name string) (fromEnv, file, hash types.String) {
+	attrs := obj.Attributes()
+	fromEnv, _ = attrs[name+"_from_env"].(types.String)
+	file, _ = attrs[name+"_file"].(types.String)
+	hash, _ = attrs[name+"_hash"].(types.String)
+	return fromEnv, file, hash
+}
@@ -0,0 +1,143 @@
+package resources
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveSecretRef(t *testing.T) {
+	t.Setenv("JITSU_TEST_SECRET", "from-env")
+	file := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(file, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatalf("writing secret file: %v", err)
+	}
+
+	tests := map[string]struct {
+		fromEnv, file types.String
+		want          string
+		ok, err       bool
+	}{
+		"none":          {fromEnv: types.StringNull(), file: types.StringNull()},
+		"env":           {fromEnv: types.StringValue("JITSU_TEST_SECRET"), file: types.StringNull(), want: "from-env", ok: true},
+		"file":          {fromEnv: types.StringNull(), file: types.StringValue(file), want: "from-file", ok: true},
+		"unset env":     {fromEnv: types.StringValue("JITSU_TEST_UNSET_SECRET"), file: types.StringNull(), err: true},
+		"missing file":  {fromEnv: types.StringNull(), file: types.StringValue(filepath.Join(t.TempDir(), "missing")), err: true},
+		"unknown":       {fromEnv: types.StringUnknown(), file: types.StringNull()},
+		"empty env var": {fromEnv: types.StringValue("JITSU_TEST_EMPTY_SECRET"), file: types.StringNull(), ok: true},
+	}
+	t.Setenv("JITSU_TEST_EMPTY_SECRET", "")
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok, err := resolveSecretRef(tc.fromEnv, tc.file)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if ok != tc.ok || got != tc.want {
+				t.Fatalf("got (%q, %v), want (%q, %v)", got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestPlanSecretHash(t *testing.T) {
+	t.Setenv("JITSU_TEST_SECRET", "from-env")
+	p := path.Root("clickhouse").AtName("password_from_env")
+	env := types.StringValue("JITSU_TEST_SECRET")
+
+	hash, diags := planSecretHash(p, env, types.StringNull(), types.StringNull())
+	if diags.HasError() || !secretHashMatches("from-env", hash.ValueString()) {
+		t.Fatalf("unexpected hash %v: %v", hash, diags)
+	}
+	if again, _ := planSecretHash(p, env, types.StringNull(), types.StringNull()); again.Equal(hash) {
+		t.Fatalf("hashes without a prior hash should use a new salt, got %v twice", hash)
+	}
+	if kept, _ := planSecretHash(p, env, types.StringNull(), hash); !kept.Equal(hash) {
+		t.Fatalf("a prior hash matching the secret should be kept, got %v, want %v", kept, hash)
+	}
+	legacy := types.StringValue("sha256:aa")
+	if replaced, _ := planSecretHash(p, env, types.StringNull(), legacy); replaced.Equal(legacy) || !secretHashMatches("from-env", replaced.ValueString()) {
+		t.Fatalf("an unsalted prior hash should be replaced, got %v", replaced)
+	}
+	if hash, _ := planSecretHash(p, types.StringNull(), types.StringNull(), types.StringNull()); !hash.IsNull() {
+		t.Fatalf("hash should be null without a reference, got %v", hash)
+	}
+	if hash, _ := planSecretHash(p, types.StringUnknown(), types.StringNull(), types.StringNull()); !hash.IsUnknown() {
+		t.Fatalf("hash should be unknown for an unknown reference, got %v", hash)
+	}
+	if _, diags := planSecretHash(p, types.StringValue("JITSU_TEST_UNSET_SECRET"), types.StringNull(), types.StringNull()); !diags.HasError() {
+		t.Fatal("expected an error for an unset environment variable")
+	}
+}
+
+func TestSecretHashMatches(t *testing.T) {
+	hash := secretHash("secret", []byte("salt"))
+	_, mac, _ := strings.Cut(strings.TrimPrefix(hash, secretHashPrefix), ":")
+	tests := map[string]struct {
+		value, hash string
+		want        bool
+	}{
+		"same value":      {value: "secret", hash: hash, want: true},
+		"other value":     {value: "other", hash: hash},
+		"other salt":      {value: "secret", hash: secretHashPrefix + "706570706572:" + mac},
+		"unsalted":        {value: "secret", hash: "sha256:2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b"},
+		"malformed salt":  {value: "secret", hash: secretHashPrefix + "zz:00"},
+		"missing the mac": {value: "secret", hash: secretHashPrefix + "73616c74"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := secretHashMatches(tc.value, tc.hash); got != tc.want {
+				t.Fatalf("secretHashMatches(%q, %q) = %v, want %v", tc.value, tc.hash, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateSecretRefConflicts(t *testing.T) {
+	attrTypes := withSecretRefTypes(map[string]attr.Type{
+		"password":    types.StringType,
+		"password_wo": types.StringType,
+	}, "password")
+	object := func(values map[string]string) types.Object {
+		attrs := map[string]attr.Value{}
+		for name := range attrTypes {
+			attrs[name] = types.StringNull()
+			if v, ok := values[name]; ok {
+				attrs[name] = types.StringValue(v)
+			}
+		}
+		return types.ObjectValueMust(attrTypes, attrs)
+	}
+
+	tests := map[string]struct {
+		obj    types.Object
+		errors int
+	}{
+		"null block":    {obj: types.ObjectNull(attrTypes), errors: 0},
+		"password only": {obj: object(map[string]string{"password": "secret"}), errors: 0},
+		"file only":     {obj: object(map[string]string{"password_file": "/run/secrets/db"}), errors: 0},
+		"password and env": {
+			obj:    object(map[string]string{"password": "secret", "password_from_env": "DB_PASSWORD"}),
+			errors: 1,
+		},
+		"env and file": {
+			obj:    object(map[string]string{"password_from_env": "DB_PASSWORD", "password_file": "/run/secrets/db"}),
+			errors: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := validateSecretRefConflicts(path.Root("clickhouse"), tc.obj, "password")
+			if got := diags.ErrorsCount(); got != tc.errors {
+				t.Fatalf("expected %d errors, got %d: %v", tc.errors, got, diags)
+			}
+		})
+	}
+}
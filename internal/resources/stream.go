@@ -6,6 +6,7 @@ import (
 
 	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,8 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &streamResource{}
-	_ resource.ResourceWithImportState = &streamResource{}
+	_ resource.Resource                   = &streamResource{}
+	_ resource.ResourceWithImportState    = &streamResource{}
+	_ resource.ResourceWithIdentity       = &streamResource{}
+	_ resource.ResourceWithValidateConfig = &streamResource{}
+	_ resource.ResourceWithModifyPlan     = &streamResource{}
 )
 
 type streamResource struct {
@@ -24,21 +28,30 @@ type streamResource struct {
 }
 
 type streamKeyModel struct {
-	ID        types.String `tfsdk:"id"`
-	Plaintext types.String `tfsdk:"plaintext"`
+	ID                 types.String `tfsdk:"id"`
+	Plaintext          types.String `tfsdk:"plaintext"`
+	PlaintextWO        types.String `tfsdk:"plaintext_wo"`
+	PlaintextWOVersion types.Int64  `tfsdk:"plaintext_wo_version"`
+	PlaintextFromEnv   types.String `tfsdk:"plaintext_from_env"`
+	PlaintextFile      types.String `tfsdk:"plaintext_file"`
+	PlaintextHash      types.String `tfsdk:"plaintext_hash"`
 }
 
-var streamKeyAttrTypes = map[string]attr.Type{
-	"id":        types.StringType,
-	"plaintext": types.StringType,
-}
+var streamKeyAttrTypes = withSecretRefTypes(map[string]attr.Type{
+	"id":                   types.StringType,
+	"plaintext":            types.StringType,
+	"plaintext_wo":         types.StringType,
+	"plaintext_wo_version": types.Int64Type,
+}, "plaintext")
 
 type streamModel struct {
-	WorkspaceID types.String `tfsdk:"workspace_id"`
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	PublicKeys  types.List   `tfsdk:"public_keys"`
-	PrivateKeys types.List   `tfsdk:"private_keys"`
+	WorkspaceID        types.String `tfsdk:"workspace_id"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	PublicKeys         types.List   `tfsdk:"public_keys"`
+	PrivateKeys        types.List   `tfsdk:"private_keys"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	CascadeDeleteLinks types.Bool   `tfsdk:"cascade_delete_links"`
 }
 
 func NewStreamResource() resource.Resource {
@@ -51,17 +64,27 @@ func (r *streamResource) Metadata(_ context.Context, req resource.MetadataReques
 
 func (r *streamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	keySchema := schema.NestedAttributeObject{
-		Attributes: map[string]schema.Attribute{
+		Attributes: withSecretRef(map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "Key identifier.",
 			},
 			"plaintext": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Plaintext key value. Write-only — API returns hashed value on read.",
+				Description: "Plaintext key value, stored in state. API returns hashed value on read. Exactly one of plaintext, plaintext_wo, plaintext_from_env or plaintext_file must be set.",
 			},
-		},
+			"plaintext_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only plaintext key value, never stored in state. Requires Terraform 1.11+.",
+			},
+			"plaintext_wo_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change this value to send a new plaintext_wo to Console. plaintext_wo is only sent when the key is created and when this value changes.",
+			},
+		}, "plaintext", "plaintext key value"),
 	}
 
 	resp.Schema = schema.Schema{
@@ -95,14 +118,109 @@ func (r *streamResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description:  "Private (server-to-server) write keys.",
 				NestedObject: keySchema,
 			},
+			"deletion_protection":  deletionProtectionAttribute(false, "stream"),
+			"cascade_delete_links": cascadeDeleteLinksAttribute("stream"),
 		},
 	}
 }
 
+func (r *streamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = objectIdentitySchema("Stream ID.")
+}
+
 func (r *streamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.client = configureClient(req, resp)
 }
 
+func (r *streamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config streamModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attrName, keys := range map[string]types.List{
+		"public_keys":  config.PublicKeys,
+		"private_keys": config.PrivateKeys,
+	} {
+		if keys.IsNull() || keys.IsUnknown() {
+			continue
+		}
+		for i, elem := range keys.Elements() {
+			obj, ok := elem.(types.Object)
+			if !ok || obj.IsNull() || obj.IsUnknown() {
+				continue
+			}
+			keyPath := path.Root(attrName).AtListIndex(i)
+			resp.Diagnostics.Append(validateSecretRefConflicts(keyPath, obj, "plaintext")...)
+			attrs := obj.Attributes()
+			if attrs["plaintext"].IsNull() && attrs["plaintext_wo"].IsNull() && !secretRefSet(obj, "plaintext") {
+				resp.Diagnostics.AddAttributeError(
+					keyPath.AtName("plaintext"),
+					"Missing plaintext",
+					"Each key must set plaintext, plaintext_wo, plaintext_from_env or plaintext_file.",
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan warns about links that would point at a destroyed stream and
+// records the hashes of key plaintexts read from environment variables or
+// files, so a changed value plans an update.
+func (r *streamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	destroys, diags := planDestroys(ctx, req, "workspace_id", "id")
+	resp.Diagnostics.Append(diags...)
+	if destroys {
+		planDependentLinks(ctx, r.client, req, resp, "stream")
+	}
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan streamModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for attrName, keys := range map[string]types.List{
+		"public_keys":  plan.PublicKeys,
+		"private_keys": plan.PrivateKeys,
+	} {
+		if keys.IsNull() || keys.IsUnknown() {
+			continue
+		}
+		priorHashes := map[string]types.String{}
+		if !req.State.Raw.IsNull() {
+			var prior []streamKeyModel
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attrName), &prior)...)
+			for _, k := range prior {
+				priorHashes[k.ID.ValueString()] = k.PlaintextHash
+			}
+		}
+		for i, elem := range keys.Elements() {
+			obj, ok := elem.(types.Object)
+			if !ok || obj.IsNull() || obj.IsUnknown() {
+				continue
+			}
+			keyPath := path.Root(attrName).AtListIndex(i)
+			fromEnv, file, _ := objectSecretRef(obj, "plaintext")
+			prior := types.StringNull()
+			if id, ok := obj.Attributes()["id"].(types.String); ok && !id.IsUnknown() {
+				if h, ok := priorHashes[id.ValueString()]; ok {
+					prior = h
+				}
+			}
+			hash, d := planSecretHash(keyPath.AtName("plaintext_from_env"), fromEnv, file, prior)
+			resp.Diagnostics.Append(d...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, keyPath.AtName("plaintext_hash"), hash)...)
+		}
+	}
+}
+
+// keysToPayload converts configured keys into the Console payload format. Pass
+// keys from config rather than plan so write-only plaintexts are available.
+// Plaintexts referenced with plaintext_from_env or plaintext_file are read here.
 func keysToPayload(ctx context.Context, keys types.List) ([]map[string]string, error) {
 	if keys.IsNull() || keys.IsUnknown() || len(keys.Elements()) == 0 {
 		return []map[string]string{}, nil
@@ -114,6 +232,14 @@ func keysToPayload(ctx context.Context, keys types.List) ([]map[string]string, e
 	result := make([]map[string]string, len(models))
 	for i, m := range models {
 		plaintext := m.Plaintext.ValueString()
+		if !m.PlaintextWO.IsNull() {
+			plaintext = m.PlaintextWO.ValueString()
+		}
+		if v, ok, err := resolveSecretRef(m.PlaintextFromEnv, m.PlaintextFile); err != nil {
+			return nil, fmt.Errorf("resolving plaintext of key %q: %w", m.ID.ValueString(), err)
+		} else if ok {
+			plaintext = v
+		}
 		result[i] = map[string]string{
 			"id":        m.ID.ValueString(),
 			"plaintext": plaintext,
@@ -123,6 +249,102 @@ func keysToPayload(ctx context.Context, keys types.List) ([]map[string]string, e
 	return result, nil
 }
 
+// keyHashes checks the plaintext_hash of each planned key against the plaintext
+// sent to Console and fills in hashes that were unknown at plan time. It fails
+// when a referenced plaintext changed between plan and apply.
+func keyHashes(ctx context.Context, keys types.List, payload []map[string]string) (types.List, error) {
+	if keys.IsNull() || keys.IsUnknown() {
+		return keys, nil
+	}
+	var models []streamKeyModel
+	if diags := keys.ElementsAs(ctx, &models, false); diags.HasError() {
+		return keys, fmt.Errorf("reading keys: %v", diags.Errors())
+	}
+	for i := range models {
+		m := &models[i]
+		if i >= len(payload) || (m.PlaintextFromEnv.IsNull() && m.PlaintextFile.IsNull()) {
+			continue
+		}
+		plaintext := payload[i]["plaintext"]
+		if !m.PlaintextHash.IsNull() && !m.PlaintextHash.IsUnknown() {
+			if !secretHashMatches(plaintext, m.PlaintextHash.ValueString()) {
+				return keys, fmt.Errorf("plaintext of key %q changed between plan and apply; run terraform plan again", m.ID.ValueString())
+			}
+			continue
+		}
+		hash, err := newSecretHash(plaintext)
+		if err != nil {
+			return keys, fmt.Errorf("hashing plaintext of key %q: %w", m.ID.ValueString(), err)
+		}
+		m.PlaintextHash = types.StringValue(hash)
+	}
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: streamKeyAttrTypes}, models)
+	if diags.HasError() {
+		return keys, fmt.Errorf("setting plaintext_hash: %v", diags.Errors())
+	}
+	return list, nil
+}
+
+// writeOnlyKeysToKeep returns the indexes of the config keys set through
+// plaintext_wo whose plaintext_wo_version did not change since prior state, so
+// their plaintext is not resent.
+func writeOnlyKeysToKeep(ctx context.Context, config, prior types.List) ([]int, error) {
+	if config.IsNull() || config.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return nil, nil
+	}
+	var models, priorModels []streamKeyModel
+	if diags := config.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil, fmt.Errorf("reading keys: %v", diags.Errors())
+	}
+	if diags := prior.ElementsAs(ctx, &priorModels, false); diags.HasError() {
+		return nil, fmt.Errorf("reading prior keys: %v", diags.Errors())
+	}
+	priorByID := make(map[string]streamKeyModel, len(priorModels))
+	for _, m := range priorModels {
+		priorByID[m.ID.ValueString()] = m
+	}
+
+	var keep []int
+	for i, m := range models {
+		if m.PlaintextWO.IsNull() {
+			continue
+		}
+		p, ok := priorByID[m.ID.ValueString()]
+		if ok && !resendWriteOnly(m.PlaintextWOVersion, p.PlaintextWOVersion, p.Plaintext, p.PlaintextHash) {
+			keep = append(keep, i)
+		}
+	}
+	return keep, nil
+}
+
+// keepWriteOnlyKeys replaces the payload keys at the indexes in keep with the
+// key Console already stores, taken from stored (the key list Console
+// returns). Keys Console no longer has are resent.
+func keepWriteOnlyKeys(keep []int, stored interface{}, payload []map[string]string) {
+	storedByID := map[string]map[string]string{}
+	items, _ := stored.([]interface{})
+	for _, item := range items {
+		key, _ := item.(map[string]interface{})
+		id, _ := key["id"].(string)
+		entry := map[string]string{}
+		for k, v := range key {
+			if s, ok := v.(string); ok {
+				entry[k] = s
+			}
+		}
+		storedByID[id] = entry
+	}
+
+	for _, i := range keep {
+		if i >= len(payload) {
+			continue
+		}
+		if entry, ok := storedByID[payload[i]["id"]]; ok && entry["hash"] != "" {
+			payload[i] = entry
+		}
+	}
+}
+
 // Match Jitsu Console ApiKeyEditor behavior: https://github.com/jitsucom/jitsu/blob/8c89a393468c4e56a2568f67df3659e850750750/webapps/console/components/ApiKeyEditor/ApiKeyEditor.tsx#L33-L35
 func keyHintFromPlaintext(plaintext string) string {
 	runes := []rune(plaintext)
@@ -141,23 +363,32 @@ func keyHintFromPlaintext(plaintext string) string {
 }
 
 func (r *streamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan streamModel
+	var plan, config streamModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Precompute key payloads before creation so conversion errors don't leave orphaned streams.
-	pubKeys, err := keysToPayload(ctx, plan.PublicKeys)
+	pubKeys, err := keysToPayload(ctx, config.PublicKeys)
 	if err != nil {
 		resp.Diagnostics.AddError("Error building public keys", err.Error())
 		return
 	}
-	privKeys, err := keysToPayload(ctx, plan.PrivateKeys)
+	privKeys, err := keysToPayload(ctx, config.PrivateKeys)
 	if err != nil {
 		resp.Diagnostics.AddError("Error building private keys", err.Error())
 		return
 	}
+	if plan.PublicKeys, err = keyHashes(ctx, plan.PublicKeys, pubKeys); err != nil {
+		resp.Diagnostics.AddError("Error building public keys", err.Error())
+		return
+	}
+	if plan.PrivateKeys, err = keyHashes(ctx, plan.PrivateKeys, privKeys); err != nil {
+		resp.Diagnostics.AddError("Error building private keys", err.Error())
+		return
+	}
 	hasKeys := pubKeys != nil || privKeys != nil
 
 	// Step 1: POST creates stream without keys
@@ -216,6 +447,7 @@ func (r *streamResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, plan.WorkspaceID, plan.ID)...)
 }
 
 func (r *streamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -241,15 +473,39 @@ func (r *streamResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Keys: API returns hashed values, not plaintext. Preserve state values.
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
 }
 
 func (r *streamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan streamModel
+	var plan, config, state streamModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Keys set through plaintext_wo are only resent when their version changes;
+	// the others keep the key Console stores, so Console is only read when
+	// there are such keys.
+	pubKeep, err := writeOnlyKeysToKeep(ctx, config.PublicKeys, state.PublicKeys)
+	if err != nil {
+		resp.Diagnostics.AddError("Error building public keys", err.Error())
+		return
+	}
+	privKeep, err := writeOnlyKeysToKeep(ctx, config.PrivateKeys, state.PrivateKeys)
+	if err != nil {
+		resp.Diagnostics.AddError("Error building private keys", err.Error())
+		return
+	}
+	var current map[string]interface{}
+	if len(pubKeep) > 0 || len(privKeep) > 0 {
+		if current, err = r.client.Read(ctx, plan.WorkspaceID.ValueString(), "stream", plan.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error reading stream", err.Error())
+			return
+		}
+	}
+
 	payload := map[string]interface{}{
 		"id":          plan.ID.ValueString(),
 		"workspaceId": plan.WorkspaceID.ValueString(),
@@ -257,20 +513,30 @@ func (r *streamResource) Update(ctx context.Context, req resource.UpdateRequest,
 		"name":        plan.Name.ValueString(),
 	}
 
-	pubKeys, err := keysToPayload(ctx, plan.PublicKeys)
+	pubKeys, err := keysToPayload(ctx, config.PublicKeys)
 	if err != nil {
 		resp.Diagnostics.AddError("Error building public keys", err.Error())
 		return
 	}
+	if plan.PublicKeys, err = keyHashes(ctx, plan.PublicKeys, pubKeys); err != nil {
+		resp.Diagnostics.AddError("Error building public keys", err.Error())
+		return
+	}
+	keepWriteOnlyKeys(pubKeep, current["publicKeys"], pubKeys)
 	if pubKeys != nil {
 		payload["publicKeys"] = pubKeys
 	}
 
-	privKeys, err := keysToPayload(ctx, plan.PrivateKeys)
+	privKeys, err := keysToPayload(ctx, config.PrivateKeys)
 	if err != nil {
 		resp.Diagnostics.AddError("Error building private keys", err.Error())
 		return
 	}
+	if plan.PrivateKeys, err = keyHashes(ctx, plan.PrivateKeys, privKeys); err != nil {
+		resp.Diagnostics.AddError("Error building private keys", err.Error())
+		return
+	}
+	keepWriteOnlyKeys(privKeep, current["privateKeys"], privKeys)
 	if privKeys != nil {
 		payload["privateKeys"] = privKeys
 	}
@@ -282,6 +548,7 @@ func (r *streamResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, plan.WorkspaceID, plan.ID)...)
 }
 
 func (r *streamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -290,6 +557,15 @@ func (r *streamResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "stream", state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(deleteDependentLinks(ctx, r.client, state.WorkspaceID.ValueString(), state.ID.ValueString(),
+		state.CascadeDeleteLinks.ValueBool(), "stream")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if err := r.client.Delete(ctx, state.WorkspaceID.ValueString(), "stream", state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting stream", err.Error())
@@ -297,7 +573,11 @@ func (r *streamResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *streamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := splitImportID(req.ID, 2)
+	parts, diags := importObjectID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if parts == nil {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: workspace_id/stream_id")
 		return
@@ -314,8 +594,10 @@ func (r *streamResource) ImportState(ctx context.Context, req resource.ImportSta
 	}
 
 	state := streamModel{
-		WorkspaceID: types.StringValue(parts[0]),
-		ID:          types.StringValue(parts[1]),
+		WorkspaceID:        types.StringValue(parts[0]),
+		ID:                 types.StringValue(parts[1]),
+		DeletionProtection: types.BoolValue(false),
+		CascadeDeleteLinks: types.BoolValue(false),
 	}
 	if v, ok := result["name"].(string); ok {
 		state.Name = types.StringValue(v)
@@ -325,4 +607,5 @@ func (r *streamResource) ImportState(ctx context.Context, req resource.ImportSta
 	state.PrivateKeys = types.ListNull(types.ObjectType{AttrTypes: streamKeyAttrTypes})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setObjectIdentity(ctx, resp.Identity, state.WorkspaceID, state.ID)...)
 }
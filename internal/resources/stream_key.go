@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &streamKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &streamKeyEphemeralResource{}
+)
+
+// streamKeyPrefixes maps key types to the prefix Console uses for their IDs and plaintexts.
+var streamKeyPrefixes = map[string]string{
+	"public":  "js.",
+	"private": "s2s.",
+}
+
+type streamKeyEphemeralResource struct{}
+
+type streamKeyEphemeralModel struct {
+	Type      types.String `tfsdk:"type"`
+	ID        types.String `tfsdk:"id"`
+	Plaintext types.String `tfsdk:"plaintext"`
+	Hint      types.String `tfsdk:"hint"`
+}
+
+func NewStreamKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &streamKeyEphemeralResource{}
+}
+
+func (r *streamKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stream_key"
+}
+
+func (r *streamKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a random stream write key without persisting it in state. " +
+			"Requires Terraform 1.10+.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Key type: public (browser, js. prefix) or private (server-to-server, s2s. prefix).",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Generated key identifier.",
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Generated plaintext key value.",
+			},
+			"hint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Key hint as displayed by Console.",
+			},
+		},
+	}
+}
+
+func (r *streamKeyEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config streamKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsNull() || config.Type.IsUnknown() {
+		return
+	}
+	if _, ok := streamKeyPrefixes[config.Type.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid key type",
+			fmt.Sprintf("Key type must be public or private, got %q.", config.Type.ValueString()),
+		)
+	}
+}
+
+func (r *streamKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config streamKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, plaintext, err := generateStreamKey(config.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating stream key", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(id)
+	config.Plaintext = types.StringValue(plaintext)
+	config.Hint = types.StringValue(keyHintFromPlaintext(plaintext))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// generateStreamKey returns a random key ID and plaintext, both carrying the
+// Console prefix for keyType.
+func generateStreamKey(keyType string) (string, string, error) {
+	prefix, ok := streamKeyPrefixes[keyType]
+	if !ok {
+		return "", "", fmt.Errorf("unknown key type %q", keyType)
+	}
+	return prefix + randomToken(), prefix + randomToken(), nil
+}
+
+// randomToken returns a lowercase base32 string carrying 128 bits of entropy.
+func randomToken() string {
+	return strings.ToLower(rand.Text())
+}
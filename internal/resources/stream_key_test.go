@@ -0,0 +1,47 @@
+package resources
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateStreamKey_UsesConsolePrefixes(t *testing.T) {
+	for keyType, prefix := range map[string]string{"public": "js.", "private": "s2s."} {
+		id, plaintext, err := generateStreamKey(keyType)
+		if err != nil {
+			t.Fatalf("unexpected error for %s key: %v", keyType, err)
+		}
+		if !strings.HasPrefix(id, prefix) {
+			t.Fatalf("%s key id %q should start with %q", keyType, id, prefix)
+		}
+		if !strings.HasPrefix(plaintext, prefix) {
+			t.Fatalf("%s key plaintext should start with %q", keyType, prefix)
+		}
+		if len(plaintext) < len(prefix)+26 {
+			t.Fatalf("%s key plaintext too short: %d chars", keyType, len(plaintext))
+		}
+		if id == plaintext {
+			t.Fatalf("%s key id and plaintext should differ", keyType)
+		}
+	}
+}
+
+func TestGenerateStreamKey_IsRandom(t *testing.T) {
+	_, first, err := generateStreamKey("public")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, second, err := generateStreamKey("public")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if first == second {
+		t.Fatal("consecutive keys should differ")
+	}
+}
+
+func TestGenerateStreamKey_RejectsUnknownType(t *testing.T) {
+	if _, _, err := generateStreamKey("browser"); err == nil {
+		t.Fatal("expected error for unknown key type")
+	}
+}
@@ -123,3 +123,138 @@ func TestKeysToPayload_EmptyList(t *testing.T) {
 		t.Fatalf("expected empty payload for empty keys, got %#v", got)
 	}
 }
+
+func TestKeysToPayload_PrefersWriteOnlyPlaintext(t *testing.T) {
+	ctx := context.Background()
+	keys, diags := types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: streamKeyAttrTypes},
+		[]streamKeyModel{
+			{
+				ID:                 types.StringValue("js.browser-key"),
+				PlaintextWO:        types.StringValue("write-only-secret"),
+				PlaintextWOVersion: types.Int64Value(1),
+			},
+		},
+	)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building keys: %v", diags)
+	}
+
+	got, err := keysToPayload(ctx, keys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []map[string]string{
+		{
+			"id":        "js.browser-key",
+			"plaintext": "write-only-secret",
+			"hint":      "wri*ret",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("keysToPayload mismatch:\n got: %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestKeysToPayload_ResolvesPlaintextRefs(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("JITSU_TEST_STREAM_KEY", "env-secret-5678")
+
+	keys, diags := types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: streamKeyAttrTypes},
+		[]streamKeyModel{
+			{
+				ID:               types.StringValue("s2s.server-key"),
+				PlaintextFromEnv: types.StringValue("JITSU_TEST_STREAM_KEY"),
+				PlaintextHash:    types.StringUnknown(),
+			},
+		},
+	)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics building keys: %v", diags)
+	}
+
+	got, err := keysToPayload(ctx, keys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []map[string]string{
+		{
+			"id":        "s2s.server-key",
+			"plaintext": "env-secret-5678",
+			"hint":      "env*678",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("keysToPayload mismatch:\n got: %#v\nwant: %#v", got, want)
+	}
+
+	hashed, err := keyHashes(ctx, keys, got)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var models []streamKeyModel
+	if diags := hashed.ElementsAs(ctx, &models, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading keys: %v", diags)
+	}
+	if !secretHashMatches("env-secret-5678", models[0].PlaintextHash.ValueString()) {
+		t.Fatalf("plaintext_hash should be filled in, got %v", models[0].PlaintextHash)
+	}
+
+	// A plaintext that changed since the plan is rejected.
+	got[0]["plaintext"] = "rotated-secret"
+	if _, err := keyHashes(ctx, hashed, got); err == nil {
+		t.Fatal("expected an error when the plaintext changed between plan and apply")
+	}
+}
+
+func TestKeepWriteOnlyKeys(t *testing.T) {
+	ctx := context.Background()
+	keyList := func(keys ...streamKeyModel) types.List {
+		list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: streamKeyAttrTypes}, keys)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics building keys: %v", diags)
+		}
+		return list
+	}
+	config := keyList(
+		streamKeyModel{ID: types.StringValue("js.kept"), PlaintextWO: types.StringValue("kept-secret"), PlaintextWOVersion: types.Int64Value(1)},
+		streamKeyModel{ID: types.StringValue("js.rotated"), PlaintextWO: types.StringValue("new-secret"), PlaintextWOVersion: types.Int64Value(2)},
+		streamKeyModel{ID: types.StringValue("js.plain"), Plaintext: types.StringValue("plain-secret")},
+	)
+	prior := keyList(
+		streamKeyModel{ID: types.StringValue("js.kept"), PlaintextWOVersion: types.Int64Value(1)},
+		streamKeyModel{ID: types.StringValue("js.rotated"), PlaintextWOVersion: types.Int64Value(1)},
+		streamKeyModel{ID: types.StringValue("js.plain"), Plaintext: types.StringValue("plain-secret")},
+	)
+	stored := []interface{}{
+		map[string]interface{}{"id": "js.kept", "hash": "kept-hash", "hint": "kep*ret"},
+		map[string]interface{}{"id": "js.rotated", "hash": "old-hash", "hint": "old*ret"},
+		map[string]interface{}{"id": "js.plain", "hash": "plain-hash", "hint": "pla*ret"},
+	}
+
+	payload, err := keysToPayload(ctx, config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	keep, err := writeOnlyKeysToKeep(ctx, config, prior)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(keep, []int{0}) {
+		t.Fatalf("expected only js.kept to be kept, got %v", keep)
+	}
+	keepWriteOnlyKeys(keep, stored, payload)
+
+	want := []map[string]string{
+		{"id": "js.kept", "hash": "kept-hash", "hint": "kep*ret"},
+		{"id": "js.rotated", "plaintext": "new-secret", "hint": "new*ret"},
+		{"id": "js.plain", "plaintext": "plain-secret", "hint": "pla*ret"},
+	}
+	if !reflect.DeepEqual(payload, want) {
+		t.Fatalf("payload mismatch:\n got: %#v\nwant: %#v", payload, want)
+	}
+}
@@ -1,11 +1,21 @@
 package resources
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // splitImportID splits an import ID by "/" and returns the parts if count matches.
@@ -26,19 +36,244 @@ func splitImportID(id string, expectedParts int) []string {
 	return parts
 }
 
+// importIDFormats lists, per resource kind, the attribute names carried by each
+// "/"-separated segment of its import ID.
+var importIDFormats = map[string][]string{
+	"workspace":     {"id"},
+	"function":      {"workspace_id", "id"},
+	"destination":   {"workspace_id", "id"},
+	"stream":        {"workspace_id", "id"},
+	"link":          {"workspace_id", "from_id", "to_id"},
+	"config_object": {"workspace_id", "type", "id"},
+}
+
+// parseImportID splits an import ID for the given resource kind into its named segments.
+func parseImportID(kind, id string) (map[string]string, error) {
+	names, ok := importIDFormats[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown resource kind %q; expected one of workspace, function, destination, stream, link, config_object", kind)
+	}
+	parts := splitImportID(id, len(names))
+	if parts == nil {
+		return nil, fmt.Errorf("invalid %s import ID %q; expected format: %s", kind, id, strings.Join(names, "/"))
+	}
+	result := make(map[string]string, len(names))
+	for i, name := range names {
+		result[name] = parts[i]
+	}
+	return result, nil
+}
+
+// objectIdentityModel is the resource identity of workspace-scoped config objects.
+type objectIdentityModel struct {
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	ID          types.String `tfsdk:"id"`
+}
+
+// objectIdentitySchema returns the identity schema of workspace-scoped config objects.
+func objectIdentitySchema(idDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Jitsu workspace ID.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       idDescription,
+			},
+		},
+	}
+}
+
+// setObjectIdentity records workspaceID and id as the resource identity.
+func setObjectIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, workspaceID, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, objectIdentityModel{WorkspaceID: workspaceID, ID: id})
+}
+
+// setWorkspaceIdentity records id as the identity of a workspace.
+func setWorkspaceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, workspaceIdentityModel{ID: id})
+}
+
+// importObjectID returns the workspace ID and object ID to import, taken from either
+// the legacy "workspace_id/id" import ID or the identity of an import block.
+// Returns nil parts if neither is well-formed.
+func importObjectID(ctx context.Context, req resource.ImportStateRequest) ([]string, diag.Diagnostics) {
+	if req.ID != "" || req.Identity == nil {
+		return splitImportID(req.ID, 2), nil
+	}
+
+	var identity objectIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if identity.WorkspaceID.ValueString() == "" || identity.ID.ValueString() == "" {
+		return nil, diags
+	}
+	return []string{identity.WorkspaceID.ValueString(), identity.ID.ValueString()}, diags
+}
+
 // configureClient extracts the *client.Client from provider data.
 // Returns nil if provider data is not yet available (during early validation).
 func configureClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *client.Client {
-	if req.ProviderData == nil {
+	return clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// configureDataSourceClient is the data source counterpart of configureClient.
+func configureDataSourceClient(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *client.Client {
+	return clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+// configureActionClient is the action counterpart of configureClient.
+func configureActionClient(req action.ConfigureRequest, resp *action.ConfigureResponse) *client.Client {
+	return clientFromProviderData(req.ProviderData, &resp.Diagnostics)
+}
+
+func clientFromProviderData(providerData any, diags *diag.Diagnostics) *client.Client {
+	if providerData == nil {
 		return nil
 	}
-	c, ok := req.ProviderData.(*client.Client)
+	c, ok := providerData.(*client.Client)
 	if !ok {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unexpected provider data type",
-			fmt.Sprintf("Expected *client.Client, got %T", req.ProviderData),
+			fmt.Sprintf("Expected *client.Client, got %T", providerData),
 		)
 		return nil
 	}
 	return c
 }
+
+// systemFields are set by the provider or Console rather than being part of a
+// config object's settings.
+var systemFields = []string{"id", "workspaceId", "type", "deleted", "createdAt", "updatedAt"}
+
+// validateJSONConfig checks the JSON-encoded attributes configAttr and
+// secretAttr: when known they must be JSON objects with disjoint keys, and
+// neither may set a reserved key.
+func validateJSONConfig(config, secret types.String, configAttr, secretAttr string, reserved []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	decode := func(v types.String, attrName string) map[string]interface{} {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		obj, err := decodeJSONObject(v.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root(attrName), fmt.Sprintf("Invalid %s JSON", attrName), err.Error())
+		}
+		return obj
+	}
+	plain, secretObj := decode(config, configAttr), decode(secret, secretAttr)
+
+	for key := range secretObj {
+		if _, ok := plain[key]; ok {
+			diags.AddAttributeError(
+				path.Root(secretAttr),
+				"Overlapping config keys",
+				fmt.Sprintf("Key %q is set in both %s and %s.", key, configAttr, secretAttr),
+			)
+		}
+	}
+	for _, key := range reserved {
+		if _, ok := plain[key]; ok {
+			diags.AddAttributeError(path.Root(configAttr), "Reserved config key",
+				fmt.Sprintf("Key %q is managed by the provider and cannot be set in %s.", key, configAttr))
+		}
+		if _, ok := secretObj[key]; ok {
+			diags.AddAttributeError(path.Root(secretAttr), "Reserved config key",
+				fmt.Sprintf("Key %q is managed by the provider and cannot be set in %s.", key, secretAttr))
+		}
+	}
+	return diags
+}
+
+// decodeJSONObject parses s as a JSON object.
+func decodeJSONObject(s string) (map[string]interface{}, error) {
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &obj); err != nil {
+		return nil, fmt.Errorf("must be a JSON object: %w", err)
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("must be a JSON object, got null")
+	}
+	return obj, nil
+}
+
+// readJSONConfig returns the JSON-encoded configuration held in result, for
+// attributes that pass an object through as a JSON string. ignored fields and
+// the keys of secret (secret values come back masked, so they are managed from
+// state) are dropped. current is returned unchanged when it is semantically
+// equal to the remote value, so formatting and key order never show as drift.
+func readJSONConfig(result map[string]interface{}, ignored []string, secret, current types.String) (types.String, error) {
+	remote := make(map[string]interface{}, len(result))
+	for k, v := range result {
+		remote[k] = v
+	}
+	for _, key := range ignored {
+		delete(remote, key)
+	}
+	if !secret.IsNull() && !secret.IsUnknown() {
+		if secretObj, err := decodeJSONObject(secret.ValueString()); err == nil {
+			for key := range secretObj {
+				delete(remote, key)
+			}
+		}
+	}
+
+	if current.IsNull() {
+		if len(remote) == 0 {
+			return current, nil
+		}
+	} else if obj, err := decodeJSONObject(current.ValueString()); err == nil && jsonEqual(obj, remote) {
+		return current, nil
+	}
+
+	normalized, err := json.Marshal(remote)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(normalized)), nil
+}
+
+// jsonEqual reports whether two decoded JSON values are equal, treating numbers
+// by value regardless of how they were written.
+func jsonEqual(a, b interface{}) bool {
+	return reflect.DeepEqual(roundTripJSON(a), roundTripJSON(b))
+}
+
+func roundTripJSON(v interface{}) interface{} {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return v
+	}
+	return out
+}
+
+// resendWriteOnly reports whether Update must send a write-only secret again:
+// when its *_wo_version changed, or when the prior state held the secret in
+// plain or through a reference (recorded by hash), so Console has an older value.
+func resendWriteOnly(version, priorVersion types.Int64, priorPlain, priorHash types.String) bool {
+	return !version.Equal(priorVersion) || !priorPlain.IsNull() || !priorHash.IsNull()
+}
+
+// writeOnlyPayload returns the payload value of a write-only secret: the
+// configured value when it is sent, otherwise client.MaskedValue so Console
+// keeps the stored secret.
+func writeOnlyPayload(value types.String, send bool) string {
+	if !send {
+		return client.MaskedValue
+	}
+	return value.ValueString()
+}
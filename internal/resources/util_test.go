@@ -1,8 +1,14 @@
 package resources
 
 import (
+	"context"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestSplitImportID_Valid(t *testing.T) {
@@ -24,3 +30,88 @@ func TestSplitImportID_RejectsEmptySegments(t *testing.T) {
 		t.Fatalf("splitImportID returned %v, want nil", got)
 	}
 }
+
+func TestParseImportID_Link(t *testing.T) {
+	got, err := parseImportID("link", "workspace/stream/destination")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"workspace_id": "workspace", "from_id": "stream", "to_id": "destination"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseImportID returned %v, want %v", got, want)
+	}
+}
+
+func TestParseImportID_RejectsWrongSegmentCount(t *testing.T) {
+	if _, err := parseImportID("stream", "workspace/stream/extra"); err == nil {
+		t.Fatal("expected error for extra segments")
+	}
+}
+
+func TestParseImportID_RejectsUnknownKind(t *testing.T) {
+	if _, err := parseImportID("connector", "workspace/id"); err == nil {
+		t.Fatal("expected error for unknown kind")
+	}
+}
+
+func TestImportObjectID_FromIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	identitySchema := objectIdentitySchema("Object ID.")
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchema,
+		Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+	}
+	if diags := identity.Set(ctx, objectIdentityModel{
+		WorkspaceID: types.StringValue("workspace"),
+		ID:          types.StringValue("object"),
+	}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics setting identity: %v", diags)
+	}
+
+	got, diags := importObjectID(ctx, resource.ImportStateRequest{Identity: identity})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := []string{"workspace", "object"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("importObjectID returned %v, want %v", got, want)
+	}
+}
+
+func TestImportObjectID_PrefersLegacyID(t *testing.T) {
+	got, diags := importObjectID(context.Background(), resource.ImportStateRequest{ID: "workspace/object"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := []string{"workspace", "object"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("importObjectID returned %v, want %v", got, want)
+	}
+}
+
+func TestSetWorkspaceIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	// Terraform clients without identity support send no identity.
+	if diags := setWorkspaceIdentity(ctx, nil, types.StringValue("workspace")); diags.HasError() {
+		t.Fatalf("unexpected diagnostics for nil identity: %v", diags)
+	}
+
+	var schemaResp resource.IdentitySchemaResponse
+	(&workspaceResource{}).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &schemaResp)
+	identity := &tfsdk.ResourceIdentity{
+		Schema: schemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(schemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+	if diags := setWorkspaceIdentity(ctx, identity, types.StringValue("workspace")); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var got workspaceIdentityModel
+	if diags := identity.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected diagnostics reading identity: %v", diags)
+	}
+	if got.ID.ValueString() != "workspace" {
+		t.Fatalf("identity id = %q, want %q", got.ID.ValueString(), "workspace")
+	}
+}
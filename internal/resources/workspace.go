@@ -6,6 +6,7 @@ import (
 
 	"github.com/chilipiper/terraform-provider-jitsu/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -15,6 +16,7 @@ import (
 var (
 	_ resource.Resource                = &workspaceResource{}
 	_ resource.ResourceWithImportState = &workspaceResource{}
+	_ resource.ResourceWithIdentity    = &workspaceResource{}
 )
 
 type workspaceResource struct {
@@ -22,9 +24,14 @@ type workspaceResource struct {
 }
 
 type workspaceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	Slug types.String `tfsdk:"slug"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Slug               types.String `tfsdk:"slug"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+}
+
+type workspaceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 func NewWorkspaceResource() resource.Resource {
@@ -54,6 +61,18 @@ func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 				Description: "Workspace slug.",
 			},
+			"deletion_protection": deletionProtectionAttribute(true, "workspace"),
+		},
+	}
+}
+
+func (r *workspaceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Workspace ID or slug.",
+			},
 		},
 	}
 }
@@ -97,6 +116,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	state := plan
 	state.ID = types.StringValue(id)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setWorkspaceIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -126,6 +146,7 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		state.Slug = types.StringValue(v)
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setWorkspaceIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -153,6 +174,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		newState.ID = state.ID
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setWorkspaceIdentity(ctx, resp.Identity, newState.ID)...)
 }
 
 func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -161,6 +183,10 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(checkDeletionProtection(state.DeletionProtection, "workspace", state.ID.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if err := r.client.WorkspaceDelete(ctx, state.ID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting workspace", err.Error())
@@ -168,7 +194,17 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *workspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := splitImportID(req.ID, 1)
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var identity workspaceIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.ID.ValueString()
+	}
+
+	parts := splitImportID(importID, 1)
 	if parts == nil {
 		resp.Diagnostics.AddError("Invalid import ID", "Expected format: workspace_id_or_slug")
 		return
@@ -185,7 +221,8 @@ func (r *workspaceResource) ImportState(ctx context.Context, req resource.Import
 	}
 
 	state := workspaceModel{
-		ID: types.StringValue(parts[0]),
+		ID:                 types.StringValue(parts[0]),
+		DeletionProtection: types.BoolValue(true),
 	}
 	if v, ok := result["id"].(string); ok && v != "" {
 		state.ID = types.StringValue(v)
@@ -198,4 +235,5 @@ func (r *workspaceResource) ImportState(ctx context.Context, req resource.Import
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setWorkspaceIdentity(ctx, resp.Identity, state.ID)...)
 }